@@ -0,0 +1,94 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+// AdaptiveConcurrency defines the adaptive concurrency limiting configuration.
+// Envoy periodically samples the latency of the requests to the backend, and uses a
+// gradient controller to adjust the number of concurrent requests allowed to the backend.
+// The requests that exceed the concurrency limit are rejected with a 503 status code.
+type AdaptiveConcurrency struct {
+	// SampleAggregatePercentile is the percentile of the sampled request latencies
+	// that is used to compute the concurrency limit.
+	// Defaults to 50.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SampleAggregatePercentile *uint32 `json:"sampleAggregatePercentile,omitempty"`
+
+	// SampleWindow is the period of time during which the request latencies are sampled
+	// before the concurrency limit is recalculated.
+	// Defaults to 100ms.
+	//
+	// +optional
+	SampleWindow *gwapiv1.Duration `json:"sampleWindow,omitempty"`
+
+	// MaxConcurrencyLimit is the upper bound of the calculated concurrency limit.
+	// Defaults to 1000.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MaxConcurrencyLimit *uint32 `json:"maxConcurrencyLimit,omitempty"`
+
+	// MinRTT defines how the minimum round-trip time of the requests, which is the
+	// ideal latency of the backend, is calculated.
+	//
+	// +optional
+	MinRTT *AdaptiveConcurrencyMinRTT `json:"minRTT,omitempty"`
+}
+
+// AdaptiveConcurrencyMinRTT defines how the minimum round-trip time (minRTT) of the
+// requests is calculated.
+//
+// +kubebuilder:validation:XValidation:rule="!(has(self.interval) && has(self.fixedValue))",message="only one of interval or fixedValue can be specified"
+// +kubebuilder:validation:XValidation:rule="has(self.fixedValue) ? !has(self.requestCount) && !has(self.jitter) && !has(self.minConcurrency) : true",message="requestCount, jitter and minConcurrency can only be specified when the minRTT is sampled"
+type AdaptiveConcurrencyMinRTT struct {
+	// Interval is the time between two minRTT recalculations.
+	// During a recalculation, the concurrency limit is lowered to minConcurrency.
+	// Defaults to 60s if fixedValue is not specified.
+	//
+	// +optional
+	Interval *gwapiv1.Duration `json:"interval,omitempty"`
+
+	// FixedValue is a fixed minRTT. If specified, the minRTT is not sampled.
+	//
+	// +optional
+	FixedValue *gwapiv1.Duration `json:"fixedValue,omitempty"`
+
+	// RequestCount is the number of requests sampled during a minRTT recalculation.
+	// Defaults to 50.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	RequestCount *uint32 `json:"requestCount,omitempty"`
+
+	// Jitter is a random delay added to the start of the minRTT recalculations, as a
+	// percentage of the interval.
+	// Defaults to 15.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Jitter *uint32 `json:"jitter,omitempty"`
+
+	// MinConcurrency is the concurrency limit applied while the minRTT is recalculated.
+	// Defaults to 3.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinConcurrency *uint32 `json:"minConcurrency,omitempty"`
+
+	// Buffer is added to the measured minRTT, as a percentage of it, to tolerate the natural
+	// variability of the latency.
+	// Defaults to 25.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Buffer *uint32 `json:"buffer,omitempty"`
+}
@@ -0,0 +1,94 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+// AdmissionControl defines the admission control configuration.
+// Envoy tracks the success rate of the requests to the backend over a sliding
+// sampling window, and probabilistically rejects new requests with a 503 status code
+// when the success rate drops below the threshold.
+type AdmissionControl struct {
+	// SuccessCriteria defines which responses are considered successful.
+	// If unspecified, HTTP responses with a status code below 500 and gRPC responses
+	// with a non-server-error status are considered successful.
+	//
+	// +optional
+	SuccessCriteria *AdmissionControlSuccessCriteria `json:"successCriteria,omitempty"`
+
+	// SamplingWindow is the sliding window of time over which the success rate is calculated.
+	// Defaults to 30s.
+	//
+	// +optional
+	SamplingWindow *gwapiv1.Duration `json:"samplingWindow,omitempty"`
+
+	// SuccessRateThreshold is the success rate percentage below which requests start being rejected.
+	// Defaults to 95.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	SuccessRateThreshold *uint32 `json:"successRateThreshold,omitempty"`
+
+	// Aggression controls how fast the rejection probability grows as the success
+	// rate drops. A value of 1 grows it linearly, higher values reject more aggressively
+	// at higher success rates.
+	// Defaults to 1.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	Aggression *float32 `json:"aggression,omitempty"`
+
+	// MinRPS is the minimum number of requests per second over the sampling window
+	// required before any request is rejected.
+	// Defaults to 0.
+	//
+	// +optional
+	MinRPS *uint32 `json:"minRPS,omitempty"`
+
+	// MaxRejectionProbability is the upper bound of the rejection probability, as a percentage.
+	// Defaults to 80.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	MaxRejectionProbability *uint32 `json:"maxRejectionProbability,omitempty"`
+}
+
+// AdmissionControlSuccessCriteria defines which responses are considered successful.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.http) || has(self.grpc)",message="at least one of http or grpc must be specified"
+type AdmissionControlSuccessCriteria struct {
+	// HTTP defines the success criteria of the HTTP responses.
+	//
+	// +optional
+	HTTP *AdmissionControlHTTPSuccessCriteria `json:"http,omitempty"`
+
+	// GRPC defines the success criteria of the gRPC responses.
+	//
+	// +optional
+	GRPC *AdmissionControlGRPCSuccessCriteria `json:"grpc,omitempty"`
+}
+
+// AdmissionControlHTTPSuccessCriteria defines the success criteria of the HTTP responses.
+type AdmissionControlHTTPSuccessCriteria struct {
+	// StatusRanges are the ranges of HTTP status codes considered successful.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	StatusRanges []StatusCodeRange `json:"statusRanges"`
+}
+
+// AdmissionControlGRPCSuccessCriteria defines the success criteria of the gRPC responses.
+type AdmissionControlGRPCSuccessCriteria struct {
+	// Statuses are the gRPC status codes considered successful, for example 0 for OK.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=17
+	// +kubebuilder:validation:items:Minimum=0
+	// +kubebuilder:validation:items:Maximum=16
+	Statuses []uint32 `json:"statuses"`
+}
@@ -5,6 +5,8 @@
 
 package v1alpha1
 
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
 // Authorization defines the authorization configuration.
 //
 // Note: if neither `Rules` nor `DefaultAction` is specified, the default action is to deny all requests.
@@ -26,6 +28,8 @@ type Authorization struct {
 }
 
 // AuthorizationRule defines a single authorization rule.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.principal) || has(self.operation)",message="at least one of principal or operation must be specified"
 type AuthorizationRule struct {
 	// Name is a user-friendly name for the rule.
 	// If not specified, Envoy Gateway will generate a unique name for the rule.
@@ -38,20 +42,90 @@ type AuthorizationRule struct {
 	// Action defines the action to be taken if the rule matches.
 	Action AuthorizationAction `json:"action"`
 
+	// Operation specifies the attributes of a request, such as HTTP methods, paths,
+	// headers and hosts.
+	// If both Operation and Principal are specified, both must match for the rule to match.
+	//
+	// +optional
+	Operation *Operation `json:"operation,omitempty"`
+
 	// Principal specifies the client identity of a request.
 	// If there are multiple principal types, all principals must match for the rule to match.
 	// For example, if there are two principals: one for client IP and one for JWT claim,
 	// the rule will match only if both the client IP and the JWT claim match.
-	Principal Principal `json:"principal"`
+	//
+	// +optional
+	Principal *Principal `json:"principal,omitempty"`
+}
+
+// Operation specifies the attributes of a request.
+// If there are multiple attribute types, all of them must match for the operation to match.
+// For example, if there are methods and paths, the operation will match only if
+// both the method and the path match.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.methods) || has(self.paths) || has(self.headers) || has(self.hosts) || has(self.serverNames)",message="at least one of methods, paths, headers, hosts or serverNames must be specified"
+type Operation struct {
+	// Methods are the HTTP methods of the request.
+	// If multiple methods are specified, one of the methods must match for the rule to match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Methods []gwapiv1.HTTPMethod `json:"methods,omitempty"`
+
+	// Paths are the paths of the request, excluding the query string.
+	// If multiple paths are specified, one of the paths must match for the rule to match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Paths []StringMatch `json:"paths,omitempty"`
+
+	// Headers are the HTTP headers of the request.
+	// If multiple headers are specified, all headers must match for the rule to match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Headers []AuthorizationHeaderMatch `json:"headers,omitempty"`
+
+	// Hosts are the hostnames of the request, matched against the Host (HTTP/1.1)
+	// or :authority (HTTP/2) header.
+	// If multiple hosts are specified, one of the hosts must match for the rule to match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Hosts []StringMatch `json:"hosts,omitempty"`
+
+	// ServerNames are the server names indicated by the client in the TLS handshake (SNI).
+	// If multiple server names are specified, one of the server names must match for the rule to match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ServerNames []StringMatch `json:"serverNames,omitempty"`
+}
+
+// AuthorizationHeaderMatch specifies how to match an HTTP header of a request.
+type AuthorizationHeaderMatch struct {
+	// Name of the HTTP header.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// StringMatch specifies how to match the value of the header.
+	StringMatch `json:",inline"`
 }
 
 // Principal specifies the client identity of a request.
-// A client identity can be a client IP, a JWT claim, username from the Authorization header,
-// or any other identity that can be extracted from a custom header.
+// A client identity can be a client IP, a JWT claim, a client certificate, username from the
+// Authorization header, or any other identity that can be extracted from a custom header.
 
 // If there are multiple principal types, all principals must match for the rule to match.
 //
-// +kubebuilder:validation:XValidation:rule="(has(self.clientCIDRs) || has(self.jwt))",message="at least one of clientCIDRs or jwt must be specified"
+// +kubebuilder:validation:XValidation:rule="(has(self.clientCIDRs) || has(self.clientCIDRsFrom) || has(self.jwt) || has(self.clientCertificate))",message="at least one of clientCIDRs, clientCIDRsFrom, jwt or clientCertificate must be specified"
 type Principal struct {
 	// ClientCIDRs are the IP CIDR ranges of the client.
 	// Valid examples are "192.168.1.0/24" or "2001:db8::/64"
@@ -67,11 +141,149 @@ type Principal struct {
 	// +kubebuilder:validation:MinItems=1
 	ClientCIDRs []CIDR `json:"clientCIDRs,omitempty"`
 
+	// ClientCIDRsFrom are the sources of additional IP CIDR ranges of the client.
+	// It's intended for large lists of CIDR ranges, such as threat intelligence
+	// blocklists, which are impractical to inline in ClientCIDRs.
+	//
+	// The CIDR ranges from all the sources are merged with the ClientCIDRs, and
+	// one of them must match the client IP for the rule to match.
+	// The CIDR ranges are reloaded when the content of a source changes.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	ClientCIDRsFrom []CIDRListSource `json:"clientCIDRsFrom,omitempty"`
+
 	// JWT authorize the request based on the JWT claims and scopes.
 	// Note: in order to use JWT claims for authorization, you must configure the
 	// JWT authentication in the same `SecurityPolicy`.
 	// +optional
 	JWT *JWTPrincipal `json:"jwt,omitempty"`
+
+	// ClientCertificate authorize the request based on the identity in the
+	// client certificate presented during the TLS handshake.
+	// Note: in order to use the client certificate for authorization, you must
+	// enable the client certificate validation with the `ClientValidation` field
+	// in the `ClientTrafficPolicy` attached to the Gateway.
+	// Requests without a client certificate never match.
+	//
+	// +optional
+	ClientCertificate *ClientCertificatePrincipal `json:"clientCertificate,omitempty"`
+}
+
+// CIDRListSourceType defines the types of sources of a CIDR list.
+// +kubebuilder:validation:Enum=ConfigMap;File
+type CIDRListSourceType string
+
+const (
+	// CIDRListSourceTypeConfigMap defines the "ConfigMap" CIDR list source type.
+	CIDRListSourceTypeConfigMap CIDRListSourceType = "ConfigMap"
+
+	// CIDRListSourceTypeFile defines the "File" CIDR list source type.
+	CIDRListSourceTypeFile CIDRListSourceType = "File"
+)
+
+// CIDRListSource defines a source of a list of IP CIDR ranges.
+//
+// The list is a newline-separated list of CIDR ranges, for example:
+//
+//	# office networks
+//	192.168.1.0/24
+//	2001:db8::/64
+//
+// Empty lines and lines starting with "#" are ignored.
+//
+// +kubebuilder:validation:XValidation:message="configMap must be set for type ConfigMap, and only for type ConfigMap",rule="self.type == 'ConfigMap' ? has(self.configMap) : !has(self.configMap)"
+// +kubebuilder:validation:XValidation:message="file must be set for type File, and only for type File",rule="self.type == 'File' ? has(self.file) : !has(self.file)"
+type CIDRListSource struct {
+	// Type is the type of the source.
+	// Valid values are ConfigMap and File.
+	//
+	// +unionDiscriminator
+	Type CIDRListSourceType `json:"type"`
+
+	// ConfigMap is a reference to a key of a ConfigMap, in the same namespace
+	// as the policy, that contains the CIDR list.
+	// Only supported by the Kubernetes provider, and by the file provider when
+	// the ConfigMap is loaded along with the policy.
+	//
+	// +optional
+	ConfigMap *CIDRListConfigMapReference `json:"configMap,omitempty"`
+
+	// File is a file on the host where Envoy Gateway runs that contains the CIDR list.
+	// Only supported by the file provider.
+	//
+	// +optional
+	File *CIDRListFile `json:"file,omitempty"`
+}
+
+// CIDRListConfigMapReference is a reference to a key of a ConfigMap that
+// contains a CIDR list.
+type CIDRListConfigMapReference struct {
+	// Name is the name of the ConfigMap.
+	Name gwapiv1.ObjectName `json:"name"`
+
+	// Key is the key of the ConfigMap that contains the CIDR list.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
+}
+
+// CIDRListFile is a file that contains a CIDR list.
+type CIDRListFile struct {
+	// Path is the absolute path of the file.
+	// The file should not be placed in the directories watched for resources
+	// by the file provider.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:Pattern=`^/`
+	Path string `json:"path"`
+}
+
+// ClientCertificatePrincipal specifies the client identity of a request based on
+// the client certificate.
+// At least one of the URI SANs, DNS SANs or subjects must be specified.
+// If multiple identity types are specified, all of them must match for the rule to match.
+//
+// Note: the email Subject Alternative Names of the client certificate can't be matched,
+// because the Envoy RBAC filter only provides the URI SANs, the DNS SANs and the
+// subject of the client certificate as matching inputs.
+//
+// +kubebuilder:validation:XValidation:rule="(has(self.uriSANs) || has(self.dnsSANs) || has(self.subjects))",message="at least one of uriSANs, dnsSANs or subjects must be specified"
+type ClientCertificatePrincipal struct {
+	// URISANs are the URI Subject Alternative Names of the client certificate,
+	// for example a SPIFFE ID like "spiffe://cluster.local/ns/default/sa/client".
+	// If the certificate contains multiple URI SANs, they are joined with a comma
+	// before being matched.
+	//
+	// If multiple URI SANs are specified, one of them must match for the rule to match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	URISANs []StringMatch `json:"uriSANs,omitempty"`
+
+	// DNSSANs are the DNS Subject Alternative Names of the client certificate.
+	// If the certificate contains multiple DNS SANs, they are joined with a comma
+	// before being matched.
+	//
+	// If multiple DNS SANs are specified, one of them must match for the rule to match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	DNSSANs []StringMatch `json:"dnsSANs,omitempty"`
+
+	// Subjects are the subjects of the client certificate, in RFC 2253 format,
+	// for example "CN=client,OU=engineering,O=example".
+	//
+	// If multiple subjects are specified, one of them must match for the rule to match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Subjects []StringMatch `json:"subjects,omitempty"`
 }
 
 // JWTPrincipal specifies the client identity of a request based on the JWT claims and scopes.
@@ -41,6 +41,7 @@ type BackendTrafficPolicy struct {
 // +kubebuilder:validation:XValidation:rule="has(self.targetRefs) ? self.targetRefs.all(ref, ref.group == 'gateway.networking.k8s.io') : true ", message="this policy can only have a targetRefs[*].group of gateway.networking.k8s.io"
 // +kubebuilder:validation:XValidation:rule="has(self.targetRefs) ? self.targetRefs.all(ref, ref.kind in ['Gateway', 'HTTPRoute', 'GRPCRoute', 'UDPRoute', 'TCPRoute', 'TLSRoute']) : true ", message="this policy can only have a targetRefs[*].kind of Gateway/HTTPRoute/GRPCRoute/TCPRoute/UDPRoute/TLSRoute"
 // +kubebuilder:validation:XValidation:rule="has(self.targetRefs) ? self.targetRefs.all(ref, !has(ref.sectionName)) : true",message="this policy does not yet support the sectionName field"
+// +kubebuilder:validation:XValidation:rule="has(self.compressionSettings) ? has(self.compression) : true",message="compressionSettings requires compression"
 //
 // BackendTrafficPolicySpec defines the desired state of BackendTrafficPolicy.
 type BackendTrafficPolicySpec struct {
@@ -65,16 +66,67 @@ type BackendTrafficPolicySpec struct {
 	UseClientProtocol *bool `json:"useClientProtocol,omitempty"`
 
 	// The compression config for the http streams.
+	// If multiple compressors are specified, the response is compressed with the one
+	// preferred by the client's Accept-Encoding header. When the client accepts several of
+	// them with the same preference, the compressor listed first is used.
 	//
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:MaxItems=3
 	// +optional
-	// +notImplementedHide
 	Compression []*Compression `json:"compression,omitempty"`
 
+	// CompressionSettings defines the settings that apply to all the compressors
+	// of Compression.
+	//
+	// +optional
+	CompressionSettings *CompressionSettings `json:"compressionSettings,omitempty"`
+
 	// ResponseOverride defines the configuration to override specific responses with a custom one.
 	// If multiple configurations are specified, the first one to match wins.
 	//
 	// +optional
 	ResponseOverride []*ResponseOverride `json:"responseOverride,omitempty"`
+
+	// OAuth2ClientCredentials configures Envoy to obtain an OAuth2 access token
+	// with the client credentials grant, and to attach it as a bearer token to
+	// the requests forwarded to the backend.
+	//
+	// +optional
+	OAuth2ClientCredentials *OAuth2ClientCredentials `json:"oauth2ClientCredentials,omitempty"`
+
+	// AdaptiveConcurrency configures Envoy to dynamically limit the number of concurrent
+	// requests to the backend, based on the sampled latency of the requests.
+	// The requests that exceed the limit are rejected with a 503 status code.
+	//
+	// +optional
+	AdaptiveConcurrency *AdaptiveConcurrency `json:"adaptiveConcurrency,omitempty"`
+
+	// AdmissionControl configures Envoy to probabilistically reject requests
+	// before they reach the backend when the success rate of the backend drops.
+	// The rejected requests receive a 503 status code.
+	//
+	// +optional
+	AdmissionControl *AdmissionControl `json:"admissionControl,omitempty"`
+
+	// Cache configures Envoy to cache the backend responses in memory, and serve
+	// the subsequent requests from the cache.
+	//
+	// +optional
+	Cache *Cache `json:"cache,omitempty"`
+
+	// RequestBuffer enables buffering the whole request body before forwarding the
+	// request to the backend, and rejecting the requests with a body larger than
+	// the configured limit.
+	//
+	// +optional
+	RequestBuffer *RequestBuffer `json:"requestBuffer,omitempty"`
+
+	// BandwidthLimit limits the bandwidth of the request and/or response bodies
+	// of the targeted routes.
+	//
+	// +optional
+	BandwidthLimit *BandwidthLimit `json:"bandwidthLimit,omitempty"`
 }
 
 // +kubebuilder:object:root=true
@@ -0,0 +1,53 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+// BandwidthLimitDirection defines the direction of the traffic whose bandwidth is limited.
+//
+// +kubebuilder:validation:Enum=Request;Response;RequestAndResponse
+type BandwidthLimitDirection string
+
+const (
+	// BandwidthLimitDirectionRequest limits the bandwidth of the request bodies
+	// sent to the backends.
+	BandwidthLimitDirectionRequest BandwidthLimitDirection = "Request"
+
+	// BandwidthLimitDirectionResponse limits the bandwidth of the response bodies
+	// sent to the clients.
+	BandwidthLimitDirectionResponse BandwidthLimitDirection = "Response"
+
+	// BandwidthLimitDirectionRequestAndResponse limits the bandwidth of both the
+	// request and the response bodies, with a separate limit for each direction.
+	BandwidthLimitDirectionRequestAndResponse BandwidthLimitDirection = "RequestAndResponse"
+)
+
+// BandwidthLimit defines the configuration for limiting the bandwidth of a route.
+// The limit is shared by all the requests of the route served by an Envoy proxy,
+// and it applies to the request and response bodies only.
+type BandwidthLimit struct {
+	// Direction is the direction of the traffic whose bandwidth is limited.
+	// Defaults to Response.
+	//
+	// +kubebuilder:default=Response
+	// +optional
+	Direction *BandwidthLimitDirection `json:"direction,omitempty"`
+
+	// LimitKbps is the bandwidth limit in KiB per second.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	LimitKbps uint64 `json:"limitKbps"`
+
+	// FillInterval is the interval at which the bandwidth is refilled, between 20ms
+	// and 1s. A shorter interval smooths the traffic at the expense of higher CPU.
+	// Defaults to 50ms.
+	//
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('20ms') && duration(self) <= duration('1s')",message="fillInterval must be between 20ms and 1s"
+	// +optional
+	FillInterval *gwapiv1.Duration `json:"fillInterval,omitempty"`
+}
@@ -0,0 +1,74 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// Cache defines the configuration for caching the backend responses.
+// The responses are cached by Envoy following the caching rules of RFC 9111:
+// only the responses that are cacheable according to their Cache-Control,
+// Expires and Vary headers are cached.
+//
+// Each route has its own store, which is kept in the /tmp directory of the Envoy
+// container, so the root filesystem of the container must be writable.
+// The size of the store is bounded by MaxSize.
+type Cache struct {
+	// MaxSize is the maximum total size of the responses cached for a route by an
+	// Envoy proxy. When the store exceeds this size, the least recently used
+	// responses are evicted.
+	// Defaults to 64Mi.
+	//
+	// +optional
+	MaxSize *resource.Quantity `json:"maxSize,omitempty"`
+
+	// MaxBodySize is the maximum size of a response body to be cached.
+	// Responses with a larger body are forwarded to the client without being cached.
+	// If unspecified, the response body size is only limited by the buffer limit
+	// of the connection.
+	//
+	// +optional
+	MaxBodySize *resource.Quantity `json:"maxBodySize,omitempty"`
+
+	// Methods is the list of request methods whose responses can be cached.
+	// Defaults to GET and HEAD.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=2
+	// +kubebuilder:validation:items:Enum=GET;HEAD
+	// +optional
+	Methods []gwapiv1.HTTPMethod `json:"methods,omitempty"`
+
+	// StatusCodes is the list of response status codes that can be cached.
+	// Responses with other status codes are never cached.
+	// If unspecified, all the status codes that are cacheable by default according
+	// to RFC 9111, such as 200, 301 and 404, can be cached.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	StatusCodes []HTTPStatus `json:"statusCodes,omitempty"`
+
+	// VaryHeaders is the list of request headers that the responses can vary on.
+	// Responses with a Vary header that lists any other request header are not cached.
+	// If unspecified, responses with a Vary header are not cached.
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	VaryHeaders []string `json:"varyHeaders,omitempty"`
+
+	// TTL overrides the freshness lifetime of the responses set by the backend.
+	// If specified, the Cache-Control header of the responses is replaced with
+	// `max-age=<TTL in seconds>`, which is also seen by the clients.
+	// The TTL is not applied to the responses that must not be stored by a shared
+	// cache: the responses with a `private` or `no-store` Cache-Control directive,
+	// and the responses with a Set-Cookie header.
+	//
+	// +optional
+	TTL *gwapiv1.Duration `json:"ttl,omitempty"`
+}
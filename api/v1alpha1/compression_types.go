@@ -7,16 +7,115 @@ package v1alpha1
 
 // CompressorType defines the types of compressor library supported by Envoy Gateway.
 //
-// +kubebuilder:validation:Enum=Gzip
+// +kubebuilder:validation:Enum=Gzip;Brotli;Zstd
 type CompressorType string
 
+const (
+	// GzipCompressorType defines the Gzip compressor.
+	GzipCompressorType CompressorType = "Gzip"
+
+	// BrotliCompressorType defines the Brotli compressor.
+	BrotliCompressorType CompressorType = "Brotli"
+
+	// ZstdCompressorType defines the Zstd compressor.
+	ZstdCompressorType CompressorType = "Zstd"
+)
+
 // GzipCompressor defines the config for the Gzip compressor.
 // The default values can be found here:
 // https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/compression/gzip/compressor/v3/gzip.proto#extension-envoy-compression-gzip-compressor
-type GzipCompressor struct{}
+type GzipCompressor struct {
+	// CompressionLevel is the zlib compression level, from 1 (fastest) to 9 (best compression).
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	// +optional
+	CompressionLevel *uint32 `json:"compressionLevel,omitempty"`
+
+	// MemoryLevel is the amount of memory used by zlib for the internal compression state,
+	// from 1 (least memory) to 9 (most memory and best speed).
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=9
+	// +optional
+	MemoryLevel *uint32 `json:"memoryLevel,omitempty"`
+
+	// WindowBits is the base two logarithm of the compressor's window size.
+	// Larger values result in better compression at the expense of memory usage.
+	//
+	// +kubebuilder:validation:Minimum=9
+	// +kubebuilder:validation:Maximum=15
+	// +optional
+	WindowBits *uint32 `json:"windowBits,omitempty"`
+}
+
+// BrotliEncoderMode defines the Brotli encoder mode.
+//
+// +kubebuilder:validation:Enum=Default;Generic;Text;Font
+type BrotliEncoderMode string
+
+const (
+	// BrotliEncoderModeDefault lets the encoder choose the mode.
+	BrotliEncoderModeDefault BrotliEncoderMode = "Default"
+
+	// BrotliEncoderModeGeneric makes no assumptions about the input.
+	BrotliEncoderModeGeneric BrotliEncoderMode = "Generic"
+
+	// BrotliEncoderModeText tunes the encoder for UTF-8 formatted text.
+	BrotliEncoderModeText BrotliEncoderMode = "Text"
+
+	// BrotliEncoderModeFont tunes the encoder for WOFF 2.0 fonts.
+	BrotliEncoderModeFont BrotliEncoderMode = "Font"
+)
+
+// BrotliCompressor defines the config for the Brotli compressor.
+// The default values can be found here:
+// https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/compression/brotli/compressor/v3/brotli.proto#extension-envoy-compression-brotli-compressor
+type BrotliCompressor struct {
+	// Quality is the compression quality, from 0 (fastest) to 11 (best compression).
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=11
+	// +optional
+	Quality *uint32 `json:"quality,omitempty"`
+
+	// WindowBits is the base two logarithm of the compressor's sliding window size.
+	// Larger values result in better compression at the expense of memory usage.
+	//
+	// +kubebuilder:validation:Minimum=10
+	// +kubebuilder:validation:Maximum=24
+	// +optional
+	WindowBits *uint32 `json:"windowBits,omitempty"`
+
+	// EncoderMode tunes the encoder for a specific type of input.
+	//
+	// +optional
+	EncoderMode *BrotliEncoderMode `json:"encoderMode,omitempty"`
+}
+
+// ZstdCompressor defines the config for the Zstd compressor.
+// The default values can be found here:
+// https://www.envoyproxy.io/docs/envoy/latest/api-v3/extensions/compression/zstd/compressor/v3/zstd.proto#extension-envoy-compression-zstd-compressor
+type ZstdCompressor struct {
+	// CompressionLevel is the compression level, from 1 (fastest) to 22 (best compression).
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=22
+	// +optional
+	CompressionLevel *uint32 `json:"compressionLevel,omitempty"`
+
+	// EnableChecksum adds a checksum at the end of each compressed frame.
+	//
+	// +optional
+	EnableChecksum *bool `json:"enableChecksum,omitempty"`
+}
 
 // Compression defines the config of enabling compression.
 // This can help reduce the bandwidth at the expense of higher CPU.
+//
+// +kubebuilder:validation:XValidation:rule="self.type == 'Gzip' ? !has(self.brotli) && !has(self.zstd) : true",message="only gzip can be specified for the Gzip compressor"
+// +kubebuilder:validation:XValidation:rule="self.type == 'Brotli' ? !has(self.gzip) && !has(self.zstd) : true",message="only brotli can be specified for the Brotli compressor"
+// +kubebuilder:validation:XValidation:rule="self.type == 'Zstd' ? !has(self.gzip) && !has(self.brotli) : true",message="only zstd can be specified for the Zstd compressor"
 type Compression struct {
 	// CompressorType defines the compressor type to use for compression.
 	//
@@ -27,4 +126,31 @@ type Compression struct {
 	//
 	// +optional
 	Gzip *GzipCompressor `json:"gzip,omitempty"`
+
+	// The configuration for Brotli compressor.
+	//
+	// +optional
+	Brotli *BrotliCompressor `json:"brotli,omitempty"`
+
+	// The configuration for Zstd compressor.
+	//
+	// +optional
+	Zstd *ZstdCompressor `json:"zstd,omitempty"`
+}
+
+// CompressionSettings defines the settings shared by all the compressors of a
+// compression config.
+type CompressionSettings struct {
+	// MinContentLength is the minimum response length, in bytes, for the response to be compressed.
+	// Defaults to 30.
+	//
+	// +optional
+	MinContentLength *uint32 `json:"minContentLength,omitempty"`
+
+	// ContentTypes is the list of response content types that are compressed.
+	// Defaults to the common text, JSON, JavaScript and XML content types.
+	//
+	// +kubebuilder:validation:MaxItems=32
+	// +optional
+	ContentTypes []string `json:"contentTypes,omitempty"`
 }
@@ -0,0 +1,33 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+// CSRF defines the configuration for Cross-Site Request Forgery (CSRF) protection.
+//
+// For requests with state-changing methods (POST, PUT, DELETE and PATCH), Envoy
+// compares the source origin of the request, taken from the Origin header or the
+// Referer header if the Origin header is absent, with the destination host of the
+// request.
+// Requests are rejected with HTTP 403 if the source origin is missing, or if it
+// doesn't match the destination host or any of the additional origins.
+type CSRF struct {
+	// AdditionalOrigins defines the additional origins that are trusted to make
+	// state-changing requests, besides the destination host of the request.
+	// They are matched against the host and port of the source origin, for
+	// example "example.com" or "example.com:8080", without the scheme.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=64
+	AdditionalOrigins []StringMatch `json:"additionalOrigins,omitempty"`
+
+	// Shadow enables the shadow (report-only) mode.
+	// In shadow mode, Envoy evaluates the requests and emits the CSRF statistics,
+	// but doesn't reject the requests that fail the origin checks.
+	// Defaults to false.
+	//
+	// +optional
+	Shadow *bool `json:"shadow,omitempty"`
+}
@@ -59,6 +59,19 @@ type EnvoyExtensionPolicySpec struct {
 	// +kubebuilder:validation:MaxItems=16
 	// +optional
 	ExtProc []ExtProc `json:"extProc,omitempty"`
+
+	// Lua is an ordered list of Lua filters
+	// that should be added to the envoy filter chain
+	//
+	// +kubebuilder:validation:MaxItems=16
+	// +optional
+	Lua []Lua `json:"lua,omitempty"`
+
+	// GRPCJSONTranscoder transcodes the RESTful JSON requests to gRPC requests for
+	// the gRPC backends, and the gRPC responses back to JSON.
+	//
+	// +optional
+	GRPCJSONTranscoder *GRPCJSONTranscoder `json:"grpcJSONTranscoder,omitempty"`
 }
 
 //+kubebuilder:object:root=true
@@ -414,6 +414,7 @@ type RateLimitTracingProvider struct {
 type RateLimitDatabaseBackend struct {
 	// Type is the type of database backend to use. Supported types are:
 	//	* Redis: Connects to a Redis database.
+	//	* Memcached: Connects to one or more Memcached servers.
 	//
 	// +unionDiscriminator
 	Type RateLimitDatabaseBackendType `json:"type"`
@@ -421,16 +422,22 @@ type RateLimitDatabaseBackend struct {
 	//
 	// +optional
 	Redis *RateLimitRedisSettings `json:"redis,omitempty"`
+	// Memcached defines the settings needed to connect to Memcached servers.
+	//
+	// +optional
+	Memcached *RateLimitMemcachedSettings `json:"memcached,omitempty"`
 }
 
 // RateLimitDatabaseBackendType specifies the types of database backend
 // to be used by the rate limit service.
-// +kubebuilder:validation:Enum=Redis
+// +kubebuilder:validation:Enum=Redis;Memcached
 type RateLimitDatabaseBackendType string
 
 const (
 	// RedisBackendType uses a redis database for the rate limit service.
 	RedisBackendType RateLimitDatabaseBackendType = "Redis"
+	// MemcachedBackendType uses memcached servers for the rate limit service.
+	MemcachedBackendType RateLimitDatabaseBackendType = "Memcached"
 )
 
 // RedisTLSSettings defines the TLS configuration for connecting to redis database.
@@ -441,15 +448,102 @@ type RedisTLSSettings struct {
 	CertificateRef *gwapiv1.SecretObjectReference `json:"certificateRef,omitempty"`
 }
 
+// RateLimitRedisType specifies the deployment mode of the redis database.
+// +kubebuilder:validation:Enum=Single;Sentinel;Cluster
+type RateLimitRedisType string
+
+const (
+	// RedisTypeSingle connects to a single redis instance.
+	RedisTypeSingle RateLimitRedisType = "Single"
+	// RedisTypeSentinel connects to a redis master discovered through redis sentinel.
+	RedisTypeSentinel RateLimitRedisType = "Sentinel"
+	// RedisTypeCluster connects to a redis cluster.
+	RedisTypeCluster RateLimitRedisType = "Cluster"
+)
+
 // RateLimitRedisSettings defines the configuration for connecting to redis database.
 type RateLimitRedisSettings struct {
 	// URL of the Redis Database.
+	// The format depends on the type of the redis deployment:
+	//	* Single: the address of the redis instance, e.g. "redis.redis-system.svc:6379".
+	//	* Sentinel: the name of the master followed by the comma separated addresses
+	//	  of the sentinels, e.g. "mymaster,sentinel-0:26379,sentinel-1:26379".
+	//	* Cluster: the comma separated addresses of the cluster nodes,
+	//	  e.g. "redis-0:6379,redis-1:6379,redis-2:6379".
 	URL string `json:"url"`
 
+	// Type is the deployment mode of the redis database.
+	// Defaults to Single.
+	//
+	// +optional
+	Type *RateLimitRedisType `json:"type,omitempty"`
+
 	// TLS defines TLS configuration for connecting to redis database.
 	//
 	// +optional
 	TLS *RedisTLSSettings `json:"tls,omitempty"`
+
+	// Auth defines the credentials used to authenticate with the redis database.
+	//
+	// +optional
+	Auth *RedisAuth `json:"auth,omitempty"`
+
+	// PoolSize is the number of connections kept in the redis connection pool.
+	// If unset, the default of the rate limit service is used.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	PoolSize *int32 `json:"poolSize,omitempty"`
+
+	// Pipeline defines the implicit pipelining of the redis commands.
+	// If unset, the commands are not pipelined.
+	//
+	// +optional
+	Pipeline *RedisPipeline `json:"pipeline,omitempty"`
+}
+
+// RedisAuth defines the credentials used to authenticate with the redis database.
+type RedisAuth struct {
+	// Username is the user used to authenticate with the redis database.
+	// If unset, only the password is sent, which authenticates as the default user.
+	//
+	// +optional
+	Username *string `json:"username,omitempty"`
+
+	// PasswordRef is a reference to the Secret holding the password under the "password" key.
+	// The Secret must be in the namespace of the rate limit service.
+	PasswordRef gwapiv1.SecretObjectReference `json:"passwordRef"`
+}
+
+// RedisPipeline defines the implicit pipelining of the redis commands.
+// At least one of window or limit must be specified.
+type RedisPipeline struct {
+	// Window is the duration to accumulate commands before flushing them to redis.
+	//
+	// +optional
+	Window *gwapiv1.Duration `json:"window,omitempty"`
+
+	// Limit is the maximum number of commands to accumulate before flushing them to redis.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	Limit *int32 `json:"limit,omitempty"`
+}
+
+// RateLimitMemcachedSettings defines the configuration for connecting to memcached servers.
+type RateLimitMemcachedSettings struct {
+	// Addresses of the memcached servers in the host:port format.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Addresses []string `json:"addresses"`
+
+	// MaxIdleConns is the maximum number of idle connections kept per memcached server.
+	// If unset, the default of the rate limit service is used.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxIdleConns *int32 `json:"maxIdleConns,omitempty"`
 }
 
 // ExtensionManager defines the configuration for registering an extension manager to
@@ -90,6 +90,7 @@ type ProxyPrometheusProvider struct {
 	// Disable the Prometheus endpoint.
 	Disable bool `json:"disable,omitempty"`
 	// Configure the compression on Prometheus endpoint. Compression is useful in situations when bandwidth is scarce and large payloads can be effectively compressed at the expense of higher CPU load.
+	// Only the compressor type is used, the settings of the compressor are ignored.
 	// +optional
 	Compression *Compression `json:"compression,omitempty"`
 }
@@ -104,10 +104,24 @@ type EnvoyProxySpec struct {
 	//
 	// - envoy.filters.http.cors
 	//
+	// - envoy.filters.http.csrf
+	//
+	// - envoy.filters.http.lua (ext_authz decision cache)
+	//
 	// - envoy.filters.http.ext_authz
 	//
+	// - envoy.filters.http.lua (ext_authz decision cache marker)
+	//
 	// - envoy.filters.http.basic_auth
 	//
+	// - envoy.filters.http.api_key_auth
+	//
+	// - envoy.filters.http.buffer (HMAC auth)
+	//
+	// - envoy.filters.http.credential_injector (HMAC auth)
+	//
+	// - envoy.filters.http.lua (HMAC auth)
+	//
 	// - envoy.filters.http.oauth2
 	//
 	// - envoy.filters.http.jwt_authn
@@ -116,18 +130,41 @@ type EnvoyProxySpec struct {
 	//
 	// - envoy.filters.http.ext_proc
 	//
+	// - envoy.filters.http.custom_response
+	//
 	// - envoy.filters.http.wasm
 	//
+	// - envoy.filters.http.lua
+	//
 	// - envoy.filters.http.rbac
 	//
 	// - envoy.filters.http.local_ratelimit
 	//
 	// - envoy.filters.http.ratelimit
 	//
-	// - envoy.filters.http.custom_response
+	// - envoy.filters.http.buffer
+	//
+	// - envoy.filters.http.bandwidth_limit
+	//
+	// - envoy.filters.http.credential_injector
+	//
+	// - envoy.filters.http.admission_control
+	//
+	// - envoy.filters.http.adaptive_concurrency
+	//
+	// - envoy.filters.http.compressor
+	//
+	// - envoy.filters.http.cache
+	//
+	// - envoy.filters.http.header_mutation (cache TTL)
+	//
+	// - envoy.filters.http.grpc_json_transcoder
 	//
 	// - envoy.filters.http.router
 	//
+	// The filters with a feature in parentheses are added by that feature. A FilterPosition
+	// for their filter type moves them together with the other filters of that type.
+	//
 	// Note: "envoy.filters.http.router" cannot be reordered, it's always the last filter in the chain.
 	//
 	// +optional
@@ -187,7 +224,7 @@ type FilterPosition struct {
 }
 
 // EnvoyFilter defines the type of Envoy HTTP filter.
-// +kubebuilder:validation:Enum=envoy.filters.http.health_check;envoy.filters.http.fault;envoy.filters.http.cors;envoy.filters.http.ext_authz;envoy.filters.http.basic_auth;envoy.filters.http.oauth2;envoy.filters.http.jwt_authn;envoy.filters.http.stateful_session;envoy.filters.http.ext_proc;envoy.filters.http.wasm;envoy.filters.http.rbac;envoy.filters.http.local_ratelimit;envoy.filters.http.ratelimit;envoy.filters.http.custom_response
+// +kubebuilder:validation:Enum=envoy.filters.http.health_check;envoy.filters.http.fault;envoy.filters.http.cors;envoy.filters.http.csrf;envoy.filters.http.ext_authz;envoy.filters.http.basic_auth;envoy.filters.http.api_key_auth;envoy.filters.http.oauth2;envoy.filters.http.jwt_authn;envoy.filters.http.stateful_session;envoy.filters.http.ext_proc;envoy.filters.http.wasm;envoy.filters.http.lua;envoy.filters.http.rbac;envoy.filters.http.local_ratelimit;envoy.filters.http.ratelimit;envoy.filters.http.buffer;envoy.filters.http.bandwidth_limit;envoy.filters.http.credential_injector;envoy.filters.http.adaptive_concurrency;envoy.filters.http.admission_control;envoy.filters.http.compressor;envoy.filters.http.cache;envoy.filters.http.grpc_json_transcoder;envoy.filters.http.custom_response
 type EnvoyFilter string
 
 const (
@@ -200,12 +237,18 @@ const (
 	// EnvoyFilterCORS defines the Envoy HTTP CORS filter.
 	EnvoyFilterCORS EnvoyFilter = "envoy.filters.http.cors"
 
+	// EnvoyFilterCSRF defines the Envoy HTTP CSRF filter.
+	EnvoyFilterCSRF EnvoyFilter = "envoy.filters.http.csrf"
+
 	// EnvoyFilterExtAuthz defines the Envoy HTTP external authorization filter.
 	EnvoyFilterExtAuthz EnvoyFilter = "envoy.filters.http.ext_authz"
 
 	// EnvoyFilterBasicAuth defines the Envoy HTTP basic authentication filter.
 	EnvoyFilterBasicAuth EnvoyFilter = "envoy.filters.http.basic_auth"
 
+	// EnvoyFilterAPIKeyAuth defines the Envoy HTTP api key authentication filter.
+	EnvoyFilterAPIKeyAuth EnvoyFilter = "envoy.filters.http.api_key_auth"
+
 	// EnvoyFilterOAuth2 defines the Envoy HTTP OAuth2 filter.
 	EnvoyFilterOAuth2 EnvoyFilter = "envoy.filters.http.oauth2"
 
@@ -221,6 +264,9 @@ const (
 	// EnvoyFilterWasm defines the Envoy HTTP WebAssembly filter.
 	EnvoyFilterWasm EnvoyFilter = "envoy.filters.http.wasm"
 
+	// EnvoyFilterLua defines the Envoy HTTP Lua filter.
+	EnvoyFilterLua EnvoyFilter = "envoy.filters.http.lua"
+
 	// EnvoyFilterRBAC defines the Envoy RBAC filter.
 	EnvoyFilterRBAC EnvoyFilter = "envoy.filters.http.rbac"
 
@@ -230,6 +276,30 @@ const (
 	// EnvoyFilterRateLimit defines the Envoy HTTP rate limit filter.
 	EnvoyFilterRateLimit EnvoyFilter = "envoy.filters.http.ratelimit"
 
+	// EnvoyFilterBuffer defines the Envoy HTTP buffer filter.
+	EnvoyFilterBuffer EnvoyFilter = "envoy.filters.http.buffer"
+
+	// EnvoyFilterBandwidthLimit defines the Envoy HTTP bandwidth limit filter.
+	EnvoyFilterBandwidthLimit EnvoyFilter = "envoy.filters.http.bandwidth_limit"
+
+	// EnvoyFilterCredentialInjector defines the Envoy HTTP credential injector filter.
+	EnvoyFilterCredentialInjector EnvoyFilter = "envoy.filters.http.credential_injector"
+
+	// EnvoyFilterAdaptiveConcurrency defines the Envoy HTTP adaptive concurrency filter.
+	EnvoyFilterAdaptiveConcurrency EnvoyFilter = "envoy.filters.http.adaptive_concurrency"
+
+	// EnvoyFilterAdmissionControl defines the Envoy HTTP admission control filter.
+	EnvoyFilterAdmissionControl EnvoyFilter = "envoy.filters.http.admission_control"
+
+	// EnvoyFilterCompressor defines the Envoy HTTP compressor filter.
+	EnvoyFilterCompressor EnvoyFilter = "envoy.filters.http.compressor"
+
+	// EnvoyFilterCache defines the Envoy HTTP cache filter.
+	EnvoyFilterCache EnvoyFilter = "envoy.filters.http.cache"
+
+	// EnvoyFilterGRPCJSONTranscoder defines the Envoy HTTP gRPC-JSON transcoder filter.
+	EnvoyFilterGRPCJSONTranscoder EnvoyFilter = "envoy.filters.http.grpc_json_transcoder"
+
 	// EnvoyFilterCustomResponse defines the Envoy HTTP custom response filter.
 	EnvoyFilterCustomResponse EnvoyFilter = "envoy.filters.http.custom_response"
 
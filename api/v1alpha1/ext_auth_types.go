@@ -5,6 +5,10 @@
 
 package v1alpha1
 
+import (
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
 // ExtAuth defines the configuration for External Authorization.
 //
 // +kubebuilder:validation:XValidation:rule="(has(self.grpc) || has(self.http))",message="one of grpc or http must be specified"
@@ -54,6 +58,84 @@ type ExtAuth struct {
 	//
 	// +optional
 	RecomputeRoute *bool `json:"recomputeRoute,omitempty"`
+
+	// ContextExtensions defines the static context that will be attached to the
+	// request to the external authorization service, for example, the name of the
+	// service that the route belongs to.
+	// For a gRPC authorization service, the context is sent in the context_extensions
+	// of the CheckRequest.
+	// For an HTTP authorization service, the context is sent as headers of the
+	// authorization request.
+	//
+	// +optional
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=16
+	ContextExtensions []ExtAuthContextExtension `json:"contextExtensions,omitempty"`
+
+	// DecisionCache enables the caching of the authorization decisions. When enabled,
+	// the allow decisions of the external authorization service are cached for the
+	// requests with the same route, method, host, path and values of the configured
+	// headers, and the external authorization service is not called for the cached
+	// requests. The deny decisions are not cached.
+	//
+	// +optional
+	DecisionCache *ExtAuthDecisionCache `json:"decisionCache,omitempty"`
+}
+
+// ExtAuthContextExtension defines a key-value pair attached to the request to
+// the external authorization service.
+type ExtAuthContextExtension struct {
+	// Name of the context extension.
+	// For an HTTP authorization service, it is used as the header name.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern=`^[A-Za-z0-9-]+$`
+	Name string `json:"name"`
+
+	// Value of the context extension.
+	Value string `json:"value"`
+}
+
+// ExtAuthDecisionCache defines the configuration of the cache of the external
+// authorization decisions.
+//
+// The cache is kept in memory by each Envoy worker thread, so a decision may be
+// computed once per worker before it is served from the cache.
+// Only the successful authorizations are cached. Negative caching is not supported:
+// the denials and the errors of the external authorization service are never cached,
+// since Envoy doesn't expose whether a rejected request was denied by the external
+// authorization service or by another filter, so every denied request is checked again.
+// The HeadersToBackend added to the request by an HTTP external authorization
+// service are added again to the cached requests. The headers added by a gRPC
+// external authorization service are not.
+type ExtAuthDecisionCache struct {
+	// Headers are the client request headers whose values form the cache key,
+	// in addition to the route, method, host and path (including the query) of
+	// the request, which are always part of the key.
+	// Requests with the same values for all of these share the same authorization
+	// decision. A missing header is treated as an empty value.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Headers []string `json:"headers"`
+
+	// TTL is the duration for which a decision is cached.
+	// Defaults to 60s.
+	//
+	// +optional
+	// +kubebuilder:validation:XValidation:rule="duration(self) >= duration('1s')",message="ttl must be at least 1s"
+	TTL *gwapiv1.Duration `json:"ttl,omitempty"`
+
+	// MaxEntries is the maximum number of decisions cached by each Envoy worker thread.
+	// When the cache is full, the oldest decision is evicted.
+	// Defaults to 1000.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100000
+	MaxEntries *uint32 `json:"maxEntries,omitempty"`
 }
 
 // GRPCExtAuthService defines the gRPC External Authorization service
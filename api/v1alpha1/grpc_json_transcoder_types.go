@@ -0,0 +1,97 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+// GRPCJSONTranscoder defines the configuration for transcoding the RESTful JSON
+// requests to gRPC requests, and the gRPC responses back to JSON.
+// The HTTP mapping of the gRPC methods is defined by the google.api.http annotations
+// of the proto descriptor set, see the Envoy gRPC-JSON transcoder documentation:
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/grpc_json_transcoder_filter
+//
+// Note: the transcoder is only applied to the requests matching the targeted routes,
+// so the JSON requests must match them before being transcoded. For a GRPCRoute,
+// this usually requires a rule without matches.
+// The transcoded requests are sent to the backends over gRPC, which requires HTTP/2,
+// so the backends of an HTTPRoute must use HTTP/2, for example with the
+// `kubernetes.io/h2c` appProtocol of the Service port. A policy targeting an HTTPRoute
+// with other backends is not accepted, and a policy targeting a Gateway only applies
+// the transcoder to the routes with HTTP/2 backends.
+type GRPCJSONTranscoder struct {
+	// ProtoDescriptorSet references the binary proto descriptor set of the gRPC services.
+	// The descriptor set must include the imports of the proto files, for example, it
+	// can be generated with `protoc --include_imports --descriptor_set_out`.
+	//
+	// +required
+	ProtoDescriptorSet ProtoDescriptorSet `json:"protoDescriptorSet"`
+
+	// Services is the list of fully qualified names of the gRPC services to transcode,
+	// for example `helloworld.Greeter`. The services must be defined in the proto
+	// descriptor set.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=64
+	// +required
+	Services []string `json:"services"`
+
+	// PrintOptions controls how the gRPC responses are printed as JSON.
+	//
+	// +optional
+	PrintOptions *GRPCJSONTranscoderPrintOptions `json:"printOptions,omitempty"`
+
+	// PassthroughUnknownPaths controls whether the requests that can't be mapped to a
+	// gRPC method of the services are forwarded to the backend as is.
+	// If set to false, these requests are rejected with an HTTP 404 error.
+	// Defaults to true.
+	//
+	// +optional
+	PassthroughUnknownPaths *bool `json:"passthroughUnknownPaths,omitempty"`
+}
+
+// ProtoDescriptorSet references a binary proto descriptor set stored in a ConfigMap
+// or a Secret, in the same namespace as the policy.
+//
+// +kubebuilder:validation:XValidation:rule="self.valueRef.kind in ['ConfigMap', 'Secret']",message="only ConfigMap and Secret are supported for valueRef"
+type ProtoDescriptorSet struct {
+	// ValueRef references the ConfigMap or Secret that contains the descriptor set.
+	//
+	// +required
+	ValueRef gwapiv1.LocalObjectReference `json:"valueRef"`
+
+	// Key is the key of the descriptor set in the referenced object.
+	// For a ConfigMap, the descriptor set must be stored in `binaryData`.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +required
+	Key string `json:"key"`
+}
+
+// GRPCJSONTranscoderPrintOptions defines how the gRPC responses are printed as JSON.
+type GRPCJSONTranscoderPrintOptions struct {
+	// AddWhitespace adds spaces, line breaks and indentation to make the JSON
+	// output easy to read.
+	//
+	// +optional
+	AddWhitespace *bool `json:"addWhitespace,omitempty"`
+
+	// AlwaysPrintPrimitiveFields prints the primitive fields even if they have
+	// their default value, which are omitted otherwise.
+	//
+	// +optional
+	AlwaysPrintPrimitiveFields *bool `json:"alwaysPrintPrimitiveFields,omitempty"`
+
+	// AlwaysPrintEnumsAsInts prints the enums as integers instead of strings.
+	//
+	// +optional
+	AlwaysPrintEnumsAsInts *bool `json:"alwaysPrintEnumsAsInts,omitempty"`
+
+	// PreserveProtoFieldNames uses the field names of the proto files in the JSON
+	// output, instead of the lowerCamelCase JSON names.
+	//
+	// +optional
+	PreserveProtoFieldNames *bool `json:"preserveProtoFieldNames,omitempty"`
+}
@@ -0,0 +1,149 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// HMACAuthSecretKey is the key of the shared secret in the Secret referenced by
+// the SecretRef of an HMACAuth.
+const HMACAuthSecretKey = "hmac-secret"
+
+// HMACAuth defines the configuration for verifying HMAC request signatures.
+//
+// The signature is computed by the client over the request body with a shared
+// secret, and sent to Envoy in a request header.
+// Envoy buffers the request body, computes the expected signature and rejects
+// the request with HTTP 401 if the signatures don't match.
+//
+// Note: Envoy doesn't have a native HMAC filter, so the request body is buffered
+// with a buffer filter, and the signature is verified with a Lua filter. The
+// shared secret is sent to Envoy over SDS, and is passed to the Lua filter by
+// a credential injector filter. These filters are ordered with the other
+// authentication filters, and move with envoy.filters.http.buffer,
+// envoy.filters.http.credential_injector and envoy.filters.http.lua in the
+// FilterOrder of the EnvoyProxy.
+type HMACAuth struct {
+	// SecretRef is the Kubernetes secret which contains the shared secret used to
+	// sign the requests.
+	//
+	// This is an Opaque secret. The shared secret should be stored in the key
+	// "hmac-secret".
+	//
+	// Note: The secret must be in the same namespace as the SecurityPolicy.
+	SecretRef gwapiv1.SecretObjectReference `json:"secretRef"`
+
+	// SignatureHeader is the name of the request header that carries the signature,
+	// for example "X-Hub-Signature-256".
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern="^[A-Za-z0-9-]+$"
+	SignatureHeader string `json:"signatureHeader"`
+
+	// SignaturePrefix is an optional prefix of the signature header value that is
+	// stripped before the signature is compared, for example "sha256=".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[ -~]*$`
+	SignaturePrefix *string `json:"signaturePrefix,omitempty"`
+
+	// Algorithm is the hash algorithm used to compute the signature.
+	// Defaults to SHA256.
+	//
+	// +optional
+	// +kubebuilder:default=SHA256
+	Algorithm *HMACAlgorithm `json:"algorithm,omitempty"`
+
+	// Encoding is the encoding of the signature in the signature header.
+	// Defaults to Hex.
+	//
+	// +optional
+	// +kubebuilder:default=Hex
+	Encoding *HMACSignatureEncoding `json:"encoding,omitempty"`
+
+	// Timestamp configures the verification of the time at which the request was
+	// signed, to protect against replay attacks.
+	// If specified, the signed payload is the payload prefix, followed by the value
+	// of the timestamp header, followed by the separator, followed by the request
+	// body, for example `<timestamp>.<body>` by default, or `v0:<timestamp>:<body>`
+	// for Slack.
+	// If not specified, the signed payload is the request body, as for GitHub.
+	//
+	// +optional
+	Timestamp *HMACTimestamp `json:"timestamp,omitempty"`
+
+	// MaxRequestBytes is the maximum size of a request body that Envoy will buffer
+	// in memory to verify the signature.
+	// Envoy will return HTTP 413 when the request body exceeds this size.
+	// Defaults to 1 MiB.
+	//
+	// +optional
+	// +kubebuilder:validation:Minimum=1
+	MaxRequestBytes *uint32 `json:"maxRequestBytes,omitempty"`
+}
+
+// HMACAlgorithm defines the hash algorithm used to compute an HMAC signature.
+//
+// +kubebuilder:validation:Enum=SHA1;SHA256
+type HMACAlgorithm string
+
+const (
+	// HMACAlgorithmSHA1 computes the signature with HMAC-SHA1.
+	HMACAlgorithmSHA1 HMACAlgorithm = "SHA1"
+	// HMACAlgorithmSHA256 computes the signature with HMAC-SHA256.
+	HMACAlgorithmSHA256 HMACAlgorithm = "SHA256"
+)
+
+// HMACSignatureEncoding defines the encoding of an HMAC signature.
+//
+// +kubebuilder:validation:Enum=Hex;Base64
+type HMACSignatureEncoding string
+
+const (
+	// HMACSignatureEncodingHex is the lowercase or uppercase hexadecimal encoding.
+	HMACSignatureEncodingHex HMACSignatureEncoding = "Hex"
+	// HMACSignatureEncodingBase64 is the standard base64 encoding with padding.
+	HMACSignatureEncodingBase64 HMACSignatureEncoding = "Base64"
+)
+
+// HMACTimestamp defines the verification of the time at which a request was signed.
+type HMACTimestamp struct {
+	// Header is the name of the request header that carries the time at which the
+	// request was signed, in seconds since the Unix epoch.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern="^[A-Za-z0-9-]+$"
+	Header string `json:"header"`
+
+	// MaxSkew is the maximum allowed difference between the timestamp in the
+	// request and the current time of Envoy.
+	// Requests with a timestamp outside of this window are rejected.
+	// Defaults to 5m.
+	//
+	// +optional
+	MaxSkew *gwapiv1.Duration `json:"maxSkew,omitempty"`
+
+	// Separator is the string between the timestamp and the request body in the
+	// signed payload.
+	// Defaults to ".".
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=16
+	// +kubebuilder:validation:Pattern=`^[ -~]*$`
+	Separator *string `json:"separator,omitempty"`
+
+	// PayloadPrefix is a string, such as a version, that precedes the timestamp in
+	// the signed payload, for example "v0:" for Slack.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=64
+	// +kubebuilder:validation:Pattern=`^[ -~]*$`
+	PayloadPrefix *string `json:"payloadPrefix,omitempty"`
+}
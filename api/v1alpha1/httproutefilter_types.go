@@ -7,11 +7,16 @@ package v1alpha1
 
 import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 )
 
 const (
 	// KindHTTPRouteFilter is the name of the HTTPRouteFilter kind.
 	KindHTTPRouteFilter = "HTTPRouteFilter"
+
+	// InjectedCredentialKey is the key of the credential in the Secret referenced
+	// by a credential injection filter.
+	InjectedCredentialKey = "credential"
 )
 
 // +kubebuilder:object:root=true
@@ -35,6 +40,8 @@ type HTTPRouteFilterSpec struct {
 	URLRewrite *HTTPURLRewriteFilter `json:"urlRewrite,omitempty"`
 	// +optional
 	DirectResponse *HTTPDirectResponseFilter `json:"directResponse,omitempty"`
+	// +optional
+	CredentialInjection *HTTPCredentialInjectionFilter `json:"credentialInjection,omitempty"`
 }
 
 // HTTPURLRewriteFilter define rewrites of HTTP URL components such as path and host
@@ -68,6 +75,45 @@ type HTTPDirectResponseFilter struct {
 	StatusCode *int `json:"statusCode,omitempty"`
 }
 
+// HTTPCredentialInjectionFilter defines the configuration to inject a credential
+// into the request before it is forwarded to the backend.
+//
+// This can be used to add an API key or a token to the requests sent to a
+// third-party service, without the credential being known by the client.
+type HTTPCredentialInjectionFilter struct {
+	// Header is the name of the request header where the credential is injected.
+	// If not specified, the credential is injected into the Authorization header.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	// +kubebuilder:validation:Pattern="^[A-Za-z0-9-]+$"
+	Header *string `json:"header,omitempty"`
+
+	// Overwrite defines whether to overwrite the header if it already exists
+	// in the request.
+	// If set to false, the request is forwarded with the existing header value.
+	// Defaults to false.
+	//
+	// +optional
+	Overwrite *bool `json:"overwrite,omitempty"`
+
+	// Credential is the credential to be injected.
+	Credential InjectedCredential `json:"credential"`
+}
+
+// InjectedCredential defines the credential to be injected into a request.
+type InjectedCredential struct {
+	// ValueRef is a reference to the Kubernetes secret containing the credential.
+	//
+	// This is an Opaque secret. The credential should be stored in the key
+	// "credential", and the value should be the full header value, for example
+	// "Bearer <token>" or "Basic <base64 encoded username:password>".
+	//
+	// Note: The secret must be in the same namespace as the HTTPRouteFilter.
+	ValueRef gwapiv1.SecretObjectReference `json:"valueRef"`
+}
+
 // HTTPPathModifierType defines the type of path redirect or rewrite.
 type HTTPPathModifierType string
 
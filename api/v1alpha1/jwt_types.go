@@ -5,6 +5,8 @@
 
 package v1alpha1
 
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
 // JWT defines the configuration for JSON Web Token (JWT) authentication.
 type JWT struct {
 	// Optional determines whether a missing JWT is acceptable, defaulting to false if not specified.
@@ -22,6 +24,7 @@ type JWT struct {
 }
 
 // JWTProvider defines how a JSON Web Token (JWT) can be verified.
+// +kubebuilder:validation:XValidation:rule="(has(self.remoteJWKS) || has(self.localJWKS)) && !(has(self.remoteJWKS) && has(self.localJWKS))", message="exactly one of remoteJWKS or localJWKS must be specified"
 // +kubebuilder:validation:XValidation:rule="(has(self.recomputeRoute) && self.recomputeRoute) ? size(self.claimToHeaders) > 0 : true", message="claimToHeaders must be specified if recomputeRoute is enabled"
 type JWTProvider struct {
 	// Name defines a unique name for the JWT provider. A name can have a variety of forms,
@@ -50,7 +53,19 @@ type JWTProvider struct {
 
 	// RemoteJWKS defines how to fetch and cache JSON Web Key Sets (JWKS) from a remote
 	// HTTP/HTTPS endpoint.
-	RemoteJWKS RemoteJWKS `json:"remoteJWKS"`
+	//
+	// Only one of RemoteJWKS or LocalJWKS can be specified.
+	//
+	// +optional
+	RemoteJWKS *RemoteJWKS `json:"remoteJWKS,omitempty"`
+
+	// LocalJWKS defines how to get the JSON Web Key Sets (JWKS) from a local source,
+	// either inline or from a ConfigMap or Secret in the same namespace as the policy.
+	//
+	// Only one of RemoteJWKS or LocalJWKS can be specified.
+	//
+	// +optional
+	LocalJWKS *LocalJWKS `json:"localJWKS,omitempty"`
 
 	// ClaimToHeaders is a list of JWT claims that must be extracted into HTTP request headers
 	// For examples, following config:
@@ -88,6 +103,46 @@ type RemoteJWKS struct {
 	// TODO: Add TBD remote JWKS fields based on defined use cases.
 }
 
+// LocalJWKSType defines the types of values for Local JWKS.
+// +kubebuilder:validation:Enum=Inline;ValueRef
+type LocalJWKSType string
+
+const (
+	// LocalJWKSTypeInline defines the "Inline" LocalJWKS type.
+	LocalJWKSTypeInline LocalJWKSType = "Inline"
+
+	// LocalJWKSTypeValueRef defines the "ValueRef" LocalJWKS type.
+	LocalJWKSTypeValueRef LocalJWKSType = "ValueRef"
+)
+
+// LocalJWKS defines how to load a JSON Web Key Sets (JWKS) from a local source,
+// either inline or from a reference to a ConfigMap or Secret.
+//
+// +kubebuilder:validation:XValidation:message="inline must be set for type Inline",rule="(!has(self.type) || self.type == 'Inline') ? has(self.inline) : true"
+// +kubebuilder:validation:XValidation:message="valueRef must be set for type ValueRef",rule="(has(self.type) && self.type == 'ValueRef') ? has(self.valueRef) : true"
+// +kubebuilder:validation:XValidation:message="only ConfigMap and Secret are supported for ValueRef",rule="has(self.valueRef) ? self.valueRef.kind in ['ConfigMap', 'Secret'] : true"
+type LocalJWKS struct {
+	// Type is the type of method to use to read the JWKS.
+	// Valid values are Inline and ValueRef, default is Inline.
+	//
+	// +kubebuilder:default=Inline
+	// +kubebuilder:validation:Enum=Inline;ValueRef
+	// +unionDiscriminator
+	Type *LocalJWKSType `json:"type"`
+
+	// Inline contains the value as an inline JWKS string.
+	//
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// ValueRef is a reference to a local ConfigMap or Secret that contains the JWKS.
+	//
+	// The ConfigMap or Secret must contain the JWKS in the `jwks` key.
+	//
+	// +optional
+	ValueRef *gwapiv1.LocalObjectReference `json:"valueRef,omitempty"`
+}
+
 // ClaimToHeader defines a configuration to convert JWT claims into HTTP headers
 type ClaimToHeader struct {
 	// Header defines the name of the HTTP request header that the JWT Claim will be saved into.
@@ -12,6 +12,7 @@ import metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 //
 // +kubebuilder:validation:XValidation:rule="self.type == 'ConsistentHash' ? has(self.consistentHash) : !has(self.consistentHash)",message="If LoadBalancer type is consistentHash, consistentHash field needs to be set."
 // +kubebuilder:validation:XValidation:rule="self.type in ['Random', 'ConsistentHash'] ? !has(self.slowStart) : true ",message="Currently SlowStart is only supported for RoundRobin and LeastRequest load balancers."
+// +kubebuilder:validation:XValidation:rule="self.type == 'ConsistentHash' ? !has(self.zoneAware) || !has(self.zoneAware.preferLocal) : true ",message="PreferLocal zone-aware routing is not supported for ConsistentHash load balancers."
 type LoadBalancer struct {
 	// Type decides the type of Load Balancer policy.
 	// Valid LoadBalancerType values are
@@ -34,6 +35,13 @@ type LoadBalancer struct {
 	//
 	// +optional
 	SlowStart *SlowStart `json:"slowStart,omitempty"`
+
+	// ZoneAware defines the configuration related to the distribution of requests between
+	// the zones of the backend endpoints.
+	// The zone of an endpoint is taken from the EndpointSlice of the backend.
+	//
+	// +optional
+	ZoneAware *ZoneAware `json:"zoneAware,omitempty"`
 }
 
 // LoadBalancerType specifies the types of LoadBalancer.
@@ -135,3 +143,59 @@ type SlowStart struct {
 	Window *metav1.Duration `json:"window"`
 	// TODO: Add support for non-linear traffic increases based on user usage.
 }
+
+// ZoneAware defines the zone-aware routing configuration.
+// Exactly one of preferLocal or weightedZones must be specified.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.preferLocal) != has(self.weightedZones)",message="exactly one of preferLocal or weightedZones must be specified"
+type ZoneAware struct {
+	// PreferLocal routes requests to the endpoints in the same zone as the Envoy proxy
+	// when possible, and spills over to the other zones when the local zone doesn't have
+	// enough endpoints to handle its share of the traffic.
+	//
+	// The zone of an Envoy proxy is read from the topology.kubernetes.io/zone label of its
+	// pod, which Kubernetes v1.33+ copies from the node with the PodTopologyLabelsAdmission
+	// plugin, or which can be set with the EnvoyProxy pod labels. The zones of the other
+	// Envoy proxies are read from the EndpointSlices of the Envoy service.
+	// Requests are distributed across all the zones when the zone of the Envoy proxy is unknown.
+	//
+	// +optional
+	PreferLocal *PreferLocalZone `json:"preferLocal,omitempty"`
+
+	// WeightedZones distributes the requests between the zones of the backend endpoints
+	// according to the specified weights.
+	// The endpoints in a zone that is not listed, or without zone information, get a weight of 1.
+	//
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	// +listType=map
+	// +listMapKey=zone
+	// +optional
+	WeightedZones []WeightedZone `json:"weightedZones,omitempty"`
+}
+
+// PreferLocalZone defines the configuration of the local zone preference.
+type PreferLocalZone struct {
+	// MinEndpointsThreshold is the minimum number of healthy endpoints of the backend required
+	// to enable the local zone preference. Below this threshold, requests are distributed
+	// across all the zones.
+	// Defaults to 6.
+	//
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	MinEndpointsThreshold *uint64 `json:"minEndpointsThreshold,omitempty"`
+}
+
+// WeightedZone defines the weight of a zone.
+type WeightedZone struct {
+	// Zone is the name of the zone, as set in the EndpointSlice of the backend,
+	// e.g. the topology.kubernetes.io/zone label of the node.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Zone string `json:"zone"`
+
+	// Weight is the relative weight of the zone.
+	//
+	// +kubebuilder:validation:Minimum=1
+	Weight uint32 `json:"weight"`
+}
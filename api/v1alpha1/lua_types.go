@@ -0,0 +1,65 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+// LuaValueType defines the types of values for Lua supported by Envoy Gateway.
+// +kubebuilder:validation:Enum=Inline;ValueRef
+type LuaValueType string
+
+const (
+	// LuaValueTypeInline defines the "Inline" Lua type.
+	LuaValueTypeInline LuaValueType = "Inline"
+
+	// LuaValueTypeValueRef defines the "ValueRef" Lua type.
+	LuaValueTypeValueRef LuaValueType = "ValueRef"
+)
+
+// Lua defines a Lua extension.
+// The script must define the `envoy_on_request` and/or `envoy_on_response` global
+// functions, see the Envoy Lua filter documentation for the available APIs:
+// https://www.envoyproxy.io/docs/envoy/latest/configuration/http/http_filters/lua_filter
+// The syntax of the script is validated when the policy is translated, and the
+// policy is not accepted if the script is invalid.
+//
+// +kubebuilder:validation:XValidation:rule="self.type == 'Inline' ? has(self.inline) && !has(self.valueRef) : true",message="inline must be set for type Inline"
+// +kubebuilder:validation:XValidation:rule="self.type == 'ValueRef' ? has(self.valueRef) && !has(self.inline) : true",message="valueRef must be set for type ValueRef"
+// +kubebuilder:validation:XValidation:rule="has(self.valueRef) ? self.valueRef.kind == 'ConfigMap' : true",message="only ConfigMap is supported for ValueRef"
+type Lua struct {
+	// Type is the type of method to use to read the Lua value.
+	// Valid values are Inline and ValueRef, default is Inline.
+	//
+	// +kubebuilder:default=Inline
+	// +unionDiscriminator
+	// +required
+	Type LuaValueType `json:"type"`
+
+	// Inline contains the source code as an inline string.
+	//
+	// +optional
+	Inline *string `json:"inline,omitempty"`
+
+	// ValueRef has the source code specified as a local object reference.
+	// Only a reference to ConfigMap is supported.
+	// The ConfigMap must contain the source code in the `lua` key.
+	//
+	// +optional
+	ValueRef *gwapiv1.LocalObjectReference `json:"valueRef,omitempty"`
+
+	// FailOpen controls the behavior when the Lua script fails while processing
+	// a request.
+	// If FailOpen is set to true, the error is logged and the request is forwarded
+	// to the backend. Otherwise, if it is set to false or not set (defaulting to false),
+	// the request is rejected with an HTTP 500 error.
+	// Errors raised while processing a response are handled the same way: the
+	// response is replaced with an empty HTTP 500 response when fail open is
+	// disabled, unless its headers have already been sent to the client.
+	//
+	// +optional
+	// +kubebuilder:default=false
+	FailOpen *bool `json:"failOpen,omitempty"`
+}
@@ -0,0 +1,88 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import (
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+)
+
+// OAuth2ClientCredentials defines the configuration to obtain an OAuth2 access
+// token with the [client credentials grant](https://datatracker.ietf.org/doc/html/rfc6749#section-4.4),
+// and to attach it to the requests forwarded to the backend.
+//
+// Envoy fetches the access token from the token endpoint, caches it until it
+// expires, and sends it to the backend as a bearer token in the Authorization
+// header. The Authorization header of the client request, if any, is overwritten.
+//
+// +kubebuilder:validation:XValidation:message="BackendRefs must be used, backendRef is not supported.",rule="!has(self.backendRef)"
+type OAuth2ClientCredentials struct {
+	// BackendRefs is used to specify the address of the token endpoint.
+	// If the BackendRefs is not specified, the host and port of the token endpoint
+	// will be used as the address of the token endpoint.
+	//
+	// TLS configuration can be specified in a BackendTLSConfig resource and target the BackendRefs.
+	//
+	// Other settings for the connection to the token endpoint can be specified in the BackendSettings resource.
+	//
+	// +optional
+	BackendCluster `json:",inline"`
+
+	// TokenEndpoint is the URL of the OAuth2 [token endpoint](https://datatracker.ietf.org/doc/html/rfc6749#section-3.2)
+	// used to obtain the access token.
+	//
+	// +kubebuilder:validation:MinLength=1
+	TokenEndpoint string `json:"tokenEndpoint"`
+
+	// ClientID is the client identifier sent to the token endpoint.
+	//
+	// +kubebuilder:validation:MinLength=1
+	ClientID string `json:"clientID"`
+
+	// ClientSecret is the Kubernetes secret which contains the client secret sent
+	// to the token endpoint.
+	//
+	// This is an Opaque secret. The client secret should be stored in the key
+	// "client-secret".
+	//
+	// Note: The secret must be in the same namespace as the BackendTrafficPolicy.
+	ClientSecret gwapiv1.SecretObjectReference `json:"clientSecret"`
+
+	// Scopes is the list of scopes requested in the access token request.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxItems=16
+	Scopes []string `json:"scopes,omitempty"`
+
+	// AuthenticationMethod defines how the client credentials are sent to the
+	// token endpoint.
+	// Defaults to ClientSecretBasic.
+	//
+	// +optional
+	// +kubebuilder:default=ClientSecretBasic
+	AuthenticationMethod *OAuth2ClientAuthenticationMethod `json:"authenticationMethod,omitempty"`
+
+	// TokenFetchRetryInterval is the interval between two attempts to fetch the
+	// access token after a failed attempt.
+	// Defaults to 2s.
+	//
+	// +optional
+	TokenFetchRetryInterval *gwapiv1.Duration `json:"tokenFetchRetryInterval,omitempty"`
+}
+
+// OAuth2ClientAuthenticationMethod defines how an OAuth2 client authenticates
+// to the token endpoint.
+//
+// +kubebuilder:validation:Enum=ClientSecretBasic;ClientSecretPost
+type OAuth2ClientAuthenticationMethod string
+
+const (
+	// OAuth2ClientSecretBasic sends the client credentials in the Authorization
+	// header with the HTTP Basic authentication scheme.
+	OAuth2ClientSecretBasic OAuth2ClientAuthenticationMethod = "ClientSecretBasic"
+	// OAuth2ClientSecretPost sends the client credentials in the form-encoded
+	// request body.
+	OAuth2ClientSecretPost OAuth2ClientAuthenticationMethod = "ClientSecretPost"
+)
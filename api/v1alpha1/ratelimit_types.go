@@ -5,6 +5,8 @@
 
 package v1alpha1
 
+import gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
 // RateLimitSpec defines the desired state of RateLimitSpec.
 // +union
 type RateLimitSpec struct {
@@ -22,6 +24,21 @@ type RateLimitSpec struct {
 	//
 	// +optional
 	Local *LocalRateLimit `json:"local,omitempty"`
+
+	// ShadowMode indicates whether the rate limits are evaluated in shadow mode.
+	// In shadow mode, the requests are still counted against the limits, and the
+	// rate limit statistics are emitted as usual, but the requests exceeding the
+	// limits are not rejected.
+	//
+	// Only the Global rate limits add the "X-RateLimit-" response headers, in
+	// shadow mode too. The Local rate limits don't add them, so their shadow
+	// mode decisions are only visible in the statistics of the local rate limit
+	// filter.
+	//
+	// This is useful to observe the effect of new limits before enforcing them.
+	//
+	// +optional
+	ShadowMode *bool `json:"shadowMode,omitempty"`
 }
 
 // RateLimitType specifies the types of RateLimiting.
@@ -91,6 +108,107 @@ type RateLimitRule struct {
 	// 429 HTTP status code is sent back to the client when
 	// the selected requests have reached the limit.
 	Limit RateLimitValue `json:"limit"`
+
+	// Cost specifies the cost of the requests and responses for this rule,
+	// that is, the number added to the rate limit counters. By default, the
+	// cost of a request is 1.
+	//
+	// This is useful to limit on something other than the number of requests,
+	// for example, the number of tokens consumed by an LLM inference backend.
+	//
+	// Note: Cost is only supported for Global Rate Limits, and requires
+	// Envoy v1.33.0 or later. The costs are configured with the rate_limits and
+	// hits_addend fields of the per-route rate limit filter config, which older
+	// Envoy versions ignore.
+	//
+	// +optional
+	Cost *RateLimitCost `json:"cost,omitempty"`
+}
+
+// RateLimitCost specifies the cost of the requests and responses for a rate limit rule.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.request) || has(self.response)",message="at least one of request or response must be specified"
+type RateLimitCost struct {
+	// Request specifies the cost of a request. The cost is added to the rate limit
+	// counters, and checked against the limit, before the request is forwarded
+	// to the backend.
+	//
+	// Setting the request cost to 0 checks whether the limit has been reached
+	// without increasing the counters, which is useful when the actual cost is
+	// only known from the response.
+	//
+	// +optional
+	Request *RateLimitCostSpecifier `json:"request,omitempty"`
+
+	// Response specifies the cost of a response. The cost is added to the rate
+	// limit counters after the response is completed, and doesn't reject the
+	// response. The subsequent requests are rejected once the limit is reached.
+	//
+	// +optional
+	Response *RateLimitCostSpecifier `json:"response,omitempty"`
+}
+
+// RateLimitCostSpecifier specifies where the cost is taken from.
+//
+// +kubebuilder:validation:XValidation:rule="self.from == 'Number' ? has(self.number) : !has(self.number)",message="number must be set for from Number, and only for from Number"
+// +kubebuilder:validation:XValidation:rule="self.from == 'Header' ? has(self.header) : !has(self.header)",message="header must be set for from Header, and only for from Header"
+// +kubebuilder:validation:XValidation:rule="self.from == 'Metadata' ? has(self.metadata) : !has(self.metadata)",message="metadata must be set for from Metadata, and only for from Metadata"
+// +union
+type RateLimitCostSpecifier struct {
+	// From specifies where the cost is taken from.
+	//
+	// +unionDiscriminator
+	From RateLimitCostFrom `json:"from"`
+
+	// Number is a fixed cost.
+	//
+	// +optional
+	// +kubebuilder:validation:Maximum=1000000000
+	Number *uint64 `json:"number,omitempty"`
+
+	// Header is the name of the header to take the cost from. For the request
+	// cost, it's a request header, and for the response cost, it's a response
+	// header. The value of the header must be a non-negative integer.
+	//
+	// +optional
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Header *string `json:"header,omitempty"`
+
+	// Metadata specifies the dynamic metadata to take the cost from. The value
+	// of the metadata must be a non-negative number, and it's usually set by an
+	// external processor or a Wasm extension.
+	//
+	// +optional
+	Metadata *RateLimitCostMetadata `json:"metadata,omitempty"`
+}
+
+// RateLimitCostFrom specifies the source of the rate limit cost.
+//
+// +kubebuilder:validation:Enum=Number;Header;Metadata
+type RateLimitCostFrom string
+
+const (
+	// RateLimitCostFromNumber takes the cost from a fixed number.
+	RateLimitCostFromNumber RateLimitCostFrom = "Number"
+	// RateLimitCostFromHeader takes the cost from a header.
+	RateLimitCostFromHeader RateLimitCostFrom = "Header"
+	// RateLimitCostFromMetadata takes the cost from the dynamic metadata.
+	RateLimitCostFromMetadata RateLimitCostFrom = "Metadata"
+)
+
+// RateLimitCostMetadata specifies the dynamic metadata to take the rate limit cost from.
+type RateLimitCostMetadata struct {
+	// Namespace is the namespace of the dynamic metadata, usually the name of
+	// the filter that sets it.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Key is the key of the dynamic metadata in the namespace.
+	//
+	// +kubebuilder:validation:MinLength=1
+	Key string `json:"key"`
 }
 
 // RateLimitSelectCondition specifies the attributes within the traffic flow that can
@@ -99,19 +217,164 @@ type RateLimitRule struct {
 type RateLimitSelectCondition struct {
 	// Headers is a list of request headers to match. Multiple header values are ANDed together,
 	// meaning, a request MUST match all the specified headers.
-	// At least one of headers or sourceCIDR condition must be specified.
+	// At least one of headers, sourceCIDR, methods, path, queryParams or jwtClaims
+	// condition must be specified.
 	//
 	// +optional
 	// +kubebuilder:validation:MaxItems=16
 	Headers []HeaderMatch `json:"headers,omitempty"`
 
 	// SourceCIDR is the client IP Address range to match on.
-	// At least one of headers or sourceCIDR condition must be specified.
+	// At least one of headers, sourceCIDR, methods, path, queryParams or jwtClaims
+	// condition must be specified.
 	//
 	// +optional
 	SourceCIDR *SourceMatch `json:"sourceCIDR,omitempty"`
+
+	// Methods is a list of HTTP methods to match.
+	// If multiple methods are specified, the request method must match one of them.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	Methods []gwapiv1.HTTPMethod `json:"methods,omitempty"`
+
+	// Path is the request path to match, excluding the query string.
+	//
+	// +optional
+	Path *StringMatch `json:"path,omitempty"`
+
+	// QueryParams is a list of request query parameters to match. Multiple query
+	// parameters are ANDed together, meaning, a request MUST match all the specified
+	// query parameters.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	QueryParams []QueryParamMatch `json:"queryParams,omitempty"`
+
+	// JWTClaims is a list of JWT claims to match. Multiple claims are ANDed together,
+	// meaning, a request MUST match all the specified claims.
+	//
+	// Note: in order to use JWT claims for rate limiting, the JWT authentication
+	// must be configured with the same provider in a `SecurityPolicy` targeting
+	// the same routes.
+	// Requests without a valid JWT, or without the claim, do not match.
+	//
+	// +optional
+	// +kubebuilder:validation:MinItems=1
+	// +kubebuilder:validation:MaxItems=16
+	JWTClaims []JWTClaimMatch `json:"jwtClaims,omitempty"`
+}
+
+// QueryParamMatch defines the match attributes within the query parameters of the request.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.type) && self.type == 'Distinct' ? !has(self.value) : has(self.value)",message="value must be set for types other than Distinct, and must not be set for Distinct"
+type QueryParamMatch struct {
+	// Type specifies how to match against the value of the query parameter.
+	//
+	// +optional
+	// +kubebuilder:default=Exact
+	Type *QueryParamMatchType `json:"type,omitempty"`
+
+	// Name of the query parameter.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=256
+	Name string `json:"name"`
+
+	// Value of the query parameter.
+	// Do not set this field when Type="Distinct", implying matching on any/all unique
+	// values of the query parameter.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Value *string `json:"value,omitempty"`
+
+	// Invert specifies whether the value match result will be inverted.
+	// Do not set this field when Type="Distinct", implying matching on any/all unique
+	// values of the query parameter.
+	//
+	// +optional
+	// +kubebuilder:default=false
+	Invert *bool `json:"invert,omitempty"`
+}
+
+// QueryParamMatchType specifies the semantics of how query parameter values should be compared.
+// Valid QueryParamMatchType values are "Exact", "RegularExpression", and "Distinct".
+//
+// +kubebuilder:validation:Enum=Exact;RegularExpression;Distinct
+type QueryParamMatchType string
+
+// QueryParamMatchType constants.
+const (
+	// QueryParamMatchExact matches the exact value of the Value field against the value
+	// of the specified query parameter.
+	QueryParamMatchExact QueryParamMatchType = "Exact"
+	// QueryParamMatchRegularExpression matches a regular expression against the value of
+	// the specified query parameter. The regex string must adhere to the syntax documented in
+	// https://github.com/google/re2/wiki/Syntax.
+	QueryParamMatchRegularExpression QueryParamMatchType = "RegularExpression"
+	// QueryParamMatchDistinct matches any and all possible unique values encountered in the
+	// specified query parameter. Note that each unique value will receive its own rate limit
+	// bucket.
+	// Note: This is only supported for Global Rate Limits.
+	QueryParamMatchDistinct QueryParamMatchType = "Distinct"
+)
+
+// JWTClaimMatch defines the match attributes within the JWT claims of the request.
+//
+// +kubebuilder:validation:XValidation:rule="has(self.type) && self.type == 'Distinct' ? !has(self.value) : has(self.value)",message="value must be set for type Exact, and only for type Exact"
+type JWTClaimMatch struct {
+	// Type specifies how to match against the value of the claim.
+	//
+	// +optional
+	// +kubebuilder:default=Exact
+	Type *JWTClaimMatchType `json:"type,omitempty"`
+
+	// Provider is the name of the JWT provider that verified the JWT token.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Provider string `json:"provider"`
+
+	// Name is the name of the claim.
+	// If it is a nested claim, use a dot (.) separated string as the name to
+	// represent the full path to the claim.
+	// For example, if the claim is in the "department" field in the "organization" field,
+	// the name should be "organization.department".
+	// Only claims with a string value are supported.
+	//
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Value of the claim.
+	// Do not set this field when Type="Distinct", implying matching on any/all unique
+	// values of the claim.
+	//
+	// +optional
+	// +kubebuilder:validation:MaxLength=1024
+	Value *string `json:"value,omitempty"`
 }
 
+// JWTClaimMatchType specifies the semantics of how JWT claim values should be compared.
+// Valid JWTClaimMatchType values are "Exact" and "Distinct".
+//
+// +kubebuilder:validation:Enum=Exact;Distinct
+type JWTClaimMatchType string
+
+// JWTClaimMatchType constants.
+const (
+	// JWTClaimMatchExact matches the exact value of the Value field against the value
+	// of the specified claim.
+	JWTClaimMatchExact JWTClaimMatchType = "Exact"
+	// JWTClaimMatchDistinct matches any and all possible unique values encountered in the
+	// specified claim. Note that each unique value will receive its own rate limit bucket.
+	// Note: This is only supported for Global Rate Limits.
+	JWTClaimMatchDistinct JWTClaimMatchType = "Distinct"
+)
+
 // +kubebuilder:validation:Enum=Exact;Distinct
 type SourceMatchType string
 
@@ -0,0 +1,28 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package v1alpha1
+
+import "k8s.io/apimachinery/pkg/api/resource"
+
+// RequestBuffer defines the configuration for buffering the request bodies.
+//
+// Note: buffering the requests disables the streaming of the request bodies to
+// the backends, so it shouldn't be enabled for the routes serving streaming
+// requests, such as gRPC client streaming or WebSocket.
+type RequestBuffer struct {
+	// Limit is the maximum size of a request body.
+	// The whole request body is buffered by Envoy before the request is forwarded
+	// to the backend, and requests with a larger body are rejected with an HTTP 413
+	// (Payload Too Large) error.
+	//
+	// Unlike the connection buffer limits, the limit applies to each request, and
+	// it takes precedence over the connection buffer limits for the request bodies.
+	//
+	// +kubebuilder:validation:XIntOrString
+	// +kubebuilder:validation:Pattern="^[1-9]+[0-9]*([EPTGMK]i|[EPTGMk])?$"
+	// +required
+	Limit resource.Quantity `json:"limit"`
+}
@@ -28,6 +28,32 @@ type Retry struct {
 	//
 	// +optional
 	PerRetry *PerRetryPolicy `json:"perRetry,omitempty"`
+
+	// Budget limits the number of concurrent retries to the backend to a percentage of
+	// the active requests, so that retries don't amplify the load on the backend during
+	// an incident. When set, it takes precedence over the maxParallelRetries of the circuit breaker.
+	//
+	// +optional
+	Budget *RetryBudget `json:"budget,omitempty"`
+}
+
+// RetryBudget defines the retry budget of the requests to the backend.
+type RetryBudget struct {
+	// Percent is the limit on the concurrent retries, as a percentage of the sum of the
+	// active and pending requests to the backend.
+	// Defaults to 20.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +optional
+	Percent *uint32 `json:"percent,omitempty"`
+
+	// MinConcurrency is the minimum number of concurrent retries allowed, regardless of the
+	// percentage of the active requests.
+	// Defaults to 3.
+	//
+	// +optional
+	MinConcurrency *uint32 `json:"minConcurrency,omitempty"`
 }
 
 type RetryOn struct {
@@ -83,6 +109,7 @@ const (
 	Unavailable TriggerEnum = "unavailable"
 )
 
+// +kubebuilder:validation:XValidation:rule="has(self.hedgeOnTimeout) && self.hedgeOnTimeout ? has(self.timeout) : true",message="timeout must be set when hedgeOnTimeout is enabled"
 type PerRetryPolicy struct {
 	// Timeout is the timeout per retry attempt.
 	//
@@ -95,6 +122,15 @@ type PerRetryPolicy struct {
 	//
 	// +optional
 	BackOff *BackOffPolicy `json:"backOff,omitempty"`
+
+	// HedgeOnTimeout enables request hedging: when the per retry timeout is reached, a
+	// speculative retry is sent to another endpoint without cancelling the attempts that are
+	// still in flight, and the first successful response is returned to the client.
+	// The retries are still limited by numRetries, and require at least one retry trigger.
+	// Defaults to false.
+	//
+	// +optional
+	HedgeOnTimeout *bool `json:"hedgeOnTimeout,omitempty"`
 }
 
 type BackOffPolicy struct {
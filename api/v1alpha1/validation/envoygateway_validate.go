@@ -7,7 +7,10 @@ package validation
 
 import (
 	"fmt"
+	"net"
 	"net/url"
+	"strings"
+	"time"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
@@ -161,14 +164,66 @@ func validateEnvoyGatewayRateLimit(rateLimit *egv1a1.RateLimit) error {
 	if rateLimit == nil {
 		return nil
 	}
-	if rateLimit.Backend.Type != egv1a1.RedisBackendType {
+	switch rateLimit.Backend.Type {
+	case egv1a1.RedisBackendType:
+		if rateLimit.Backend.Memcached != nil {
+			return fmt.Errorf("ratelimit memcached settings must not be set for backend %v", rateLimit.Backend.Type)
+		}
+		return validateEnvoyGatewayRateLimitRedis(rateLimit.Backend.Redis)
+	case egv1a1.MemcachedBackendType:
+		if rateLimit.Backend.Redis != nil {
+			return fmt.Errorf("ratelimit redis settings must not be set for backend %v", rateLimit.Backend.Type)
+		}
+		return validateEnvoyGatewayRateLimitMemcached(rateLimit.Backend.Memcached)
+	default:
 		return fmt.Errorf("unsupported ratelimit backend %v", rateLimit.Backend.Type)
 	}
-	if rateLimit.Backend.Redis == nil || rateLimit.Backend.Redis.URL == "" {
+}
+
+func validateEnvoyGatewayRateLimitRedis(redis *egv1a1.RateLimitRedisSettings) error {
+	if redis == nil || redis.URL == "" {
 		return fmt.Errorf("empty ratelimit redis settings")
 	}
-	if _, err := url.Parse(rateLimit.Backend.Redis.URL); err != nil {
-		return fmt.Errorf("unknown ratelimit redis url format: %w", err)
+	redisType := egv1a1.RedisTypeSingle
+	if redis.Type != nil {
+		redisType = *redis.Type
+	}
+	switch redisType {
+	case egv1a1.RedisTypeSingle:
+		if _, err := url.Parse(redis.URL); err != nil {
+			return fmt.Errorf("unknown ratelimit redis url format: %w", err)
+		}
+	case egv1a1.RedisTypeSentinel:
+		// The sentinel url is the name of the master followed by the sentinel addresses.
+		if len(strings.Split(redis.URL, ",")) < 2 {
+			return fmt.Errorf("ratelimit redis sentinel url must contain the master name and at least one sentinel address")
+		}
+	case egv1a1.RedisTypeCluster:
+	default:
+		return fmt.Errorf("unsupported ratelimit redis type %v", redisType)
+	}
+	if redis.Auth != nil && redis.Auth.PasswordRef.Name == "" {
+		return fmt.Errorf("empty ratelimit redis auth passwordRef")
+	}
+	if redis.Pipeline != nil && redis.Pipeline.Window == nil && redis.Pipeline.Limit == nil {
+		return fmt.Errorf("at least one of window or limit must be specified in ratelimit redis pipeline")
+	}
+	if redis.Pipeline != nil && redis.Pipeline.Window != nil {
+		if _, err := time.ParseDuration(string(*redis.Pipeline.Window)); err != nil {
+			return fmt.Errorf("invalid ratelimit redis pipeline window: %w", err)
+		}
+	}
+	return nil
+}
+
+func validateEnvoyGatewayRateLimitMemcached(memcached *egv1a1.RateLimitMemcachedSettings) error {
+	if memcached == nil || len(memcached.Addresses) == 0 {
+		return fmt.Errorf("empty ratelimit memcached settings")
+	}
+	for _, address := range memcached.Addresses {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return fmt.Errorf("invalid ratelimit memcached address %s: %w", address, err)
+		}
 	}
 	return nil
 }
@@ -11,6 +11,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -302,6 +303,168 @@ func TestValidateEnvoyGateway(t *testing.T) {
 			},
 			expect: true,
 		},
+		{
+			name: "happy ratelimit redis sentinel settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL:      "mymaster,sentinel-0:26379,sentinel-1:26379",
+								Type:     ptr.To(egv1a1.RedisTypeSentinel),
+								PoolSize: ptr.To[int32](20),
+								Pipeline: &egv1a1.RedisPipeline{
+									Window: ptr.To(gwapiv1.Duration("150us")),
+									Limit:  ptr.To[int32](8),
+								},
+								Auth: &egv1a1.RedisAuth{
+									Username: ptr.To("ratelimit"),
+									PasswordRef: gwapiv1.SecretObjectReference{
+										Name: "redis-auth",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "ratelimit redis sentinel url without sentinels",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL:  "mymaster",
+								Type: ptr.To(egv1a1.RedisTypeSentinel),
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "empty ratelimit redis pipeline",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL:      "localhost:6376",
+								Pipeline: &egv1a1.RedisPipeline{},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "invalid ratelimit redis pipeline window",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.RedisBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL: "localhost:6376",
+								Pipeline: &egv1a1.RedisPipeline{
+									Window: ptr.To(gwapiv1.Duration("foo")),
+								},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "happy ratelimit memcached settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+							Memcached: &egv1a1.RateLimitMemcachedSettings{
+								Addresses:    []string{"memcached-0:11211", "memcached-1:11211"},
+								MaxIdleConns: ptr.To[int32](10),
+							},
+						},
+					},
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "empty ratelimit memcached settings",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "invalid ratelimit memcached address",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+							Memcached: &egv1a1.RateLimitMemcachedSettings{
+								Addresses: []string{"memcached"},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
+		{
+			name: "ratelimit redis settings with memcached backend",
+			eg: &egv1a1.EnvoyGateway{
+				EnvoyGatewaySpec: egv1a1.EnvoyGatewaySpec{
+					Gateway:  egv1a1.DefaultGateway(),
+					Provider: egv1a1.DefaultEnvoyGatewayProvider(),
+					RateLimit: &egv1a1.RateLimit{
+						Backend: egv1a1.RateLimitDatabaseBackend{
+							Type: egv1a1.MemcachedBackendType,
+							Redis: &egv1a1.RateLimitRedisSettings{
+								URL: "localhost:6376",
+							},
+							Memcached: &egv1a1.RateLimitMemcachedSettings{
+								Addresses: []string{"memcached:11211"},
+							},
+						},
+					},
+				},
+			},
+			expect: false,
+		},
 		{
 			name: "happy extension settings",
 			eg: &egv1a1.EnvoyGateway{
@@ -85,11 +85,21 @@ func ValidateJWTProvider(providers []egv1a1.JWTProvider) error {
 				}
 			}
 
-		case len(provider.RemoteJWKS.URI) == 0:
+		case provider.RemoteJWKS != nil && len(provider.RemoteJWKS.URI) == 0:
 			errs = append(errs, fmt.Errorf("uri must be set for remote JWKS provider: %s", provider.Name))
 		}
-		if _, err := url.ParseRequestURI(provider.RemoteJWKS.URI); err != nil {
-			errs = append(errs, fmt.Errorf("invalid remote JWKS URI: %w", err))
+		if (provider.RemoteJWKS == nil) == (provider.LocalJWKS == nil) {
+			errs = append(errs, fmt.Errorf("exactly one of remoteJWKS or localJWKS must be set for JWT provider: %s", provider.Name))
+		}
+		if provider.RemoteJWKS != nil {
+			if _, err := url.ParseRequestURI(provider.RemoteJWKS.URI); err != nil {
+				errs = append(errs, fmt.Errorf("invalid remote JWKS URI: %w", err))
+			}
+		}
+		if provider.LocalJWKS != nil {
+			if err := validateLocalJWKS(provider.LocalJWKS); err != nil {
+				errs = append(errs, err)
+			}
 		}
 
 		if len(errs) == 0 {
@@ -124,3 +134,18 @@ func ValidateJWTProvider(providers []egv1a1.JWTProvider) error {
 
 	return utilerrors.NewAggregate(errs)
 }
+
+// validateLocalJWKS validates the provided local JWKS configuration.
+func validateLocalJWKS(jwks *egv1a1.LocalJWKS) error {
+	if jwks.Type != nil && *jwks.Type == egv1a1.LocalJWKSTypeValueRef {
+		if jwks.ValueRef == nil {
+			return errors.New("valueRef must be set for local JWKS of type ValueRef")
+		}
+		return nil
+	}
+
+	if jwks.Inline == nil || len(*jwks.Inline) == 0 {
+		return errors.New("inline must be set for local JWKS of type Inline")
+	}
+	return nil
+}
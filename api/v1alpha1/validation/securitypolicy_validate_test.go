@@ -10,6 +10,7 @@ import (
 
 	"github.com/stretchr/testify/require"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 )
@@ -58,7 +59,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "https://www.test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -86,7 +87,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "test@test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -114,7 +115,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "foo.bar.local",
 								Audiences: []string{"foo.bar.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -142,7 +143,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "test@test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 								ClaimToHeaders: []egv1a1.ClaimToHeader{
@@ -176,7 +177,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "unqualified_...",
 								Issuer:    "https://www.test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -204,7 +205,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "",
 								Issuer:    "https://www.test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -232,7 +233,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "unique",
 								Issuer:    "https://www.test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -240,7 +241,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "non-unique",
 								Issuer:    "https://www.test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -248,7 +249,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "non-unique",
 								Issuer:    "https://www.test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -276,7 +277,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "http://invalid url.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "http://www.test.local",
 								},
 							},
@@ -304,7 +305,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "test@!123...",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -332,7 +333,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "http://www.test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "invalid/local",
 								},
 							},
@@ -359,7 +360,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 							{
 								Name:      "test",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "",
 								},
 							},
@@ -387,7 +388,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "test@test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 								ClaimToHeaders: []egv1a1.ClaimToHeader{
@@ -421,7 +422,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 								Name:      "test",
 								Issuer:    "test@test.local",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 								ClaimToHeaders: []egv1a1.ClaimToHeader{
@@ -454,7 +455,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 							{
 								Name:      "test",
 								Audiences: []string{"test.local"},
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -481,7 +482,7 @@ func TestValidateSecurityPolicy(t *testing.T) {
 							{
 								Name:   "test",
 								Issuer: "https://www.test.local",
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://test.local/jwt/public-key/jwks.json",
 								},
 							},
@@ -491,6 +492,92 @@ func TestValidateSecurityPolicy(t *testing.T) {
 			},
 			expected: true,
 		},
+		{
+			name: "valid security policy with local jwks",
+			policy: &egv1a1.SecurityPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindSecurityPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name:   "test",
+								Issuer: "https://www.test.local",
+								LocalJWKS: &egv1a1.LocalJWKS{
+									Type:   ptr.To(egv1a1.LocalJWKSTypeInline),
+									Inline: ptr.To(`{"keys":[]}`),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: true,
+		},
+		{
+			name: "unspecified local jwks inline",
+			policy: &egv1a1.SecurityPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindSecurityPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name:   "test",
+								Issuer: "https://www.test.local",
+								LocalJWKS: &egv1a1.LocalJWKS{
+									Type: ptr.To(egv1a1.LocalJWKSTypeInline),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
+		{
+			name: "both remote and local jwks",
+			policy: &egv1a1.SecurityPolicy{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       egv1a1.KindSecurityPolicy,
+					APIVersion: egv1a1.GroupVersion.String(),
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "test",
+					Name:      "test",
+				},
+				Spec: egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name:   "test",
+								Issuer: "https://www.test.local",
+								RemoteJWKS: &egv1a1.RemoteJWKS{
+									URI: "https://test.local/jwt/public-key/jwks.json",
+								},
+								LocalJWKS: &egv1a1.LocalJWKS{
+									Type:   ptr.To(egv1a1.LocalJWKSTypeInline),
+									Inline: ptr.To(`{"keys":[]}`),
+								},
+							},
+						},
+					},
+				},
+			},
+			expected: false,
+		},
 	}
 
 	for i := range testCases {
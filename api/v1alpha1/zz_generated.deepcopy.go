@@ -14,9 +14,9 @@ import (
 	"k8s.io/api/autoscaling/v2"
 	corev1 "k8s.io/api/core/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
-	"k8s.io/apimachinery/pkg/apis/meta/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	runtime "k8s.io/apimachinery/pkg/runtime"
-	apisv1 "sigs.k8s.io/gateway-api/apis/v1"
+	"sigs.k8s.io/gateway-api/apis/v1"
 	"sigs.k8s.io/gateway-api/apis/v1alpha2"
 )
 
@@ -76,17 +76,55 @@ func (in *ALSEnvoyProxyHTTPAccessLogConfig) DeepCopy() *ALSEnvoyProxyHTTPAccessL
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyAuth) DeepCopyInto(out *APIKeyAuth) {
+	*out = *in
+	if in.CredentialRefs != nil {
+		in, out := &in.CredentialRefs, &out.CredentialRefs
+		*out = make([]v1.SecretObjectReference, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ExtractFrom != nil {
+		in, out := &in.ExtractFrom, &out.ExtractFrom
+		*out = make([]*ExtractFrom, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ExtractFrom)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ForwardClientIDHeader != nil {
+		in, out := &in.ForwardClientIDHeader, &out.ForwardClientIDHeader
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyAuth.
+func (in *APIKeyAuth) DeepCopy() *APIKeyAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ActiveHealthCheck) DeepCopyInto(out *ActiveHealthCheck) {
 	*out = *in
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.UnhealthyThreshold != nil {
@@ -151,6 +189,196 @@ func (in *ActiveHealthCheckPayload) DeepCopy() *ActiveHealthCheckPayload {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrency) DeepCopyInto(out *AdaptiveConcurrency) {
+	*out = *in
+	if in.SampleAggregatePercentile != nil {
+		in, out := &in.SampleAggregatePercentile, &out.SampleAggregatePercentile
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SampleWindow != nil {
+		in, out := &in.SampleWindow, &out.SampleWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConcurrencyLimit != nil {
+		in, out := &in.MaxConcurrencyLimit, &out.MaxConcurrencyLimit
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinRTT != nil {
+		in, out := &in.MinRTT, &out.MinRTT
+		*out = new(AdaptiveConcurrencyMinRTT)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrency.
+func (in *AdaptiveConcurrency) DeepCopy() *AdaptiveConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrencyMinRTT) DeepCopyInto(out *AdaptiveConcurrencyMinRTT) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FixedValue != nil {
+		in, out := &in.FixedValue, &out.FixedValue
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequestCount != nil {
+		in, out := &in.RequestCount, &out.RequestCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinConcurrency != nil {
+		in, out := &in.MinConcurrency, &out.MinConcurrency
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrencyMinRTT.
+func (in *AdaptiveConcurrencyMinRTT) DeepCopy() *AdaptiveConcurrencyMinRTT {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrencyMinRTT)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionControl) DeepCopyInto(out *AdmissionControl) {
+	*out = *in
+	if in.SuccessCriteria != nil {
+		in, out := &in.SuccessCriteria, &out.SuccessCriteria
+		*out = new(AdmissionControlSuccessCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.SamplingWindow != nil {
+		in, out := &in.SamplingWindow, &out.SamplingWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SuccessRateThreshold != nil {
+		in, out := &in.SuccessRateThreshold, &out.SuccessRateThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Aggression != nil {
+		in, out := &in.Aggression, &out.Aggression
+		*out = new(float32)
+		**out = **in
+	}
+	if in.MinRPS != nil {
+		in, out := &in.MinRPS, &out.MinRPS
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRejectionProbability != nil {
+		in, out := &in.MaxRejectionProbability, &out.MaxRejectionProbability
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionControl.
+func (in *AdmissionControl) DeepCopy() *AdmissionControl {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionControlGRPCSuccessCriteria) DeepCopyInto(out *AdmissionControlGRPCSuccessCriteria) {
+	*out = *in
+	if in.Statuses != nil {
+		in, out := &in.Statuses, &out.Statuses
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionControlGRPCSuccessCriteria.
+func (in *AdmissionControlGRPCSuccessCriteria) DeepCopy() *AdmissionControlGRPCSuccessCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionControlGRPCSuccessCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionControlHTTPSuccessCriteria) DeepCopyInto(out *AdmissionControlHTTPSuccessCriteria) {
+	*out = *in
+	if in.StatusRanges != nil {
+		in, out := &in.StatusRanges, &out.StatusRanges
+		*out = make([]StatusCodeRange, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionControlHTTPSuccessCriteria.
+func (in *AdmissionControlHTTPSuccessCriteria) DeepCopy() *AdmissionControlHTTPSuccessCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionControlHTTPSuccessCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionControlSuccessCriteria) DeepCopyInto(out *AdmissionControlSuccessCriteria) {
+	*out = *in
+	if in.HTTP != nil {
+		in, out := &in.HTTP, &out.HTTP
+		*out = new(AdmissionControlHTTPSuccessCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.GRPC != nil {
+		in, out := &in.GRPC, &out.GRPC
+		*out = new(AdmissionControlGRPCSuccessCriteria)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionControlSuccessCriteria.
+func (in *AdmissionControlSuccessCriteria) DeepCopy() *AdmissionControlSuccessCriteria {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionControlSuccessCriteria)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Authorization) DeepCopyInto(out *Authorization) {
 	*out = *in
@@ -178,6 +406,22 @@ func (in *Authorization) DeepCopy() *Authorization {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AuthorizationHeaderMatch) DeepCopyInto(out *AuthorizationHeaderMatch) {
+	*out = *in
+	in.StringMatch.DeepCopyInto(&out.StringMatch)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationHeaderMatch.
+func (in *AuthorizationHeaderMatch) DeepCopy() *AuthorizationHeaderMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(AuthorizationHeaderMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizationRule) DeepCopyInto(out *AuthorizationRule) {
 	*out = *in
@@ -186,7 +430,16 @@ func (in *AuthorizationRule) DeepCopyInto(out *AuthorizationRule) {
 		*out = new(string)
 		**out = **in
 	}
-	in.Principal.DeepCopyInto(&out.Principal)
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(Operation)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Principal != nil {
+		in, out := &in.Principal, &out.Principal
+		*out = new(Principal)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AuthorizationRule.
@@ -204,12 +457,12 @@ func (in *BackOffPolicy) DeepCopyInto(out *BackOffPolicy) {
 	*out = *in
 	if in.BaseInterval != nil {
 		in, out := &in.BaseInterval, &out.BaseInterval
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.MaxInterval != nil {
 		in, out := &in.MaxInterval, &out.MaxInterval
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
@@ -256,7 +509,7 @@ func (in *BackendCluster) DeepCopyInto(out *BackendCluster) {
 	*out = *in
 	if in.BackendRef != nil {
 		in, out := &in.BackendRef, &out.BackendRef
-		*out = new(apisv1.BackendObjectReference)
+		*out = new(v1.BackendObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.BackendRefs != nil {
@@ -428,7 +681,7 @@ func (in *BackendStatus) DeepCopyInto(out *BackendStatus) {
 	*out = *in
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
-		*out = make([]v1.Condition, len(*in))
+		*out = make([]metav1.Condition, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -450,7 +703,7 @@ func (in *BackendTLSConfig) DeepCopyInto(out *BackendTLSConfig) {
 	*out = *in
 	if in.ClientCertificateRef != nil {
 		in, out := &in.ClientCertificateRef, &out.ClientCertificateRef
-		*out = new(apisv1.SecretObjectReference)
+		*out = new(v1.SecretObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
 	in.TLSSettings.DeepCopyInto(&out.TLSSettings)
@@ -556,6 +809,11 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 			}
 		}
 	}
+	if in.CompressionSettings != nil {
+		in, out := &in.CompressionSettings, &out.CompressionSettings
+		*out = new(CompressionSettings)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ResponseOverride != nil {
 		in, out := &in.ResponseOverride, &out.ResponseOverride
 		*out = make([]*ResponseOverride, len(*in))
@@ -567,6 +825,36 @@ func (in *BackendTrafficPolicySpec) DeepCopyInto(out *BackendTrafficPolicySpec)
 			}
 		}
 	}
+	if in.OAuth2ClientCredentials != nil {
+		in, out := &in.OAuth2ClientCredentials, &out.OAuth2ClientCredentials
+		*out = new(OAuth2ClientCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdaptiveConcurrency != nil {
+		in, out := &in.AdaptiveConcurrency, &out.AdaptiveConcurrency
+		*out = new(AdaptiveConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionControl != nil {
+		in, out := &in.AdmissionControl, &out.AdmissionControl
+		*out = new(AdmissionControl)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestBuffer != nil {
+		in, out := &in.RequestBuffer, &out.RequestBuffer
+		*out = new(RequestBuffer)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BackendTrafficPolicySpec.
@@ -579,6 +867,31 @@ func (in *BackendTrafficPolicySpec) DeepCopy() *BackendTrafficPolicySpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	if in.Direction != nil {
+		in, out := &in.Direction, &out.Direction
+		*out = new(BandwidthLimitDirection)
+		**out = **in
+	}
+	if in.FillInterval != nil {
+		in, out := &in.FillInterval, &out.FillInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicAuth) DeepCopyInto(out *BasicAuth) {
 	*out = *in
@@ -610,6 +923,91 @@ func (in *BodyToExtAuth) DeepCopy() *BodyToExtAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BrotliCompressor) DeepCopyInto(out *BrotliCompressor) {
+	*out = *in
+	if in.Quality != nil {
+		in, out := &in.Quality, &out.Quality
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.WindowBits != nil {
+		in, out := &in.WindowBits, &out.WindowBits
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.EncoderMode != nil {
+		in, out := &in.EncoderMode, &out.EncoderMode
+		*out = new(BrotliEncoderMode)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BrotliCompressor.
+func (in *BrotliCompressor) DeepCopy() *BrotliCompressor {
+	if in == nil {
+		return nil
+	}
+	out := new(BrotliCompressor)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRListConfigMapReference) DeepCopyInto(out *CIDRListConfigMapReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRListConfigMapReference.
+func (in *CIDRListConfigMapReference) DeepCopy() *CIDRListConfigMapReference {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRListConfigMapReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRListFile) DeepCopyInto(out *CIDRListFile) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRListFile.
+func (in *CIDRListFile) DeepCopy() *CIDRListFile {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRListFile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CIDRListSource) DeepCopyInto(out *CIDRListSource) {
+	*out = *in
+	if in.ConfigMap != nil {
+		in, out := &in.ConfigMap, &out.ConfigMap
+		*out = new(CIDRListConfigMapReference)
+		**out = **in
+	}
+	if in.File != nil {
+		in, out := &in.File, &out.File
+		*out = new(CIDRListFile)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CIDRListSource.
+func (in *CIDRListSource) DeepCopy() *CIDRListSource {
+	if in == nil {
+		return nil
+	}
+	out := new(CIDRListSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CORS) DeepCopyInto(out *CORS) {
 	*out = *in
@@ -635,7 +1033,7 @@ func (in *CORS) DeepCopyInto(out *CORS) {
 	}
 	if in.MaxAge != nil {
 		in, out := &in.MaxAge, &out.MaxAge
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.AllowCredentials != nil {
@@ -655,6 +1053,78 @@ func (in *CORS) DeepCopy() *CORS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRF) DeepCopyInto(out *CSRF) {
+	*out = *in
+	if in.AdditionalOrigins != nil {
+		in, out := &in.AdditionalOrigins, &out.AdditionalOrigins
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Shadow != nil {
+		in, out := &in.Shadow, &out.Shadow
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRF.
+func (in *CSRF) DeepCopy() *CSRF {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cache) DeepCopyInto(out *Cache) {
+	*out = *in
+	if in.MaxSize != nil {
+		in, out := &in.MaxSize, &out.MaxSize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.MaxBodySize != nil {
+		in, out := &in.MaxBodySize, &out.MaxBodySize
+		x := (*in).DeepCopy()
+		*out = &x
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]v1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]HTTPStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.VaryHeaders != nil {
+		in, out := &in.VaryHeaders, &out.VaryHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
+	if in == nil {
+		return nil
+	}
+	out := new(Cache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -710,6 +1180,42 @@ func (in *ClaimToHeader) DeepCopy() *ClaimToHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertificatePrincipal) DeepCopyInto(out *ClientCertificatePrincipal) {
+	*out = *in
+	if in.URISANs != nil {
+		in, out := &in.URISANs, &out.URISANs
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DNSSANs != nil {
+		in, out := &in.DNSSANs, &out.DNSSANs
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertificatePrincipal.
+func (in *ClientCertificatePrincipal) DeepCopy() *ClientCertificatePrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertificatePrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnection) DeepCopyInto(out *ClientConnection) {
 	*out = *in
@@ -956,7 +1462,7 @@ func (in *ClientValidationContext) DeepCopyInto(out *ClientValidationContext) {
 	*out = *in
 	if in.CACertificateRefs != nil {
 		in, out := &in.CACertificateRefs, &out.CACertificateRefs
-		*out = make([]apisv1.SecretObjectReference, len(*in))
+		*out = make([]v1.SecretObjectReference, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
@@ -1044,7 +1550,17 @@ func (in *Compression) DeepCopyInto(out *Compression) {
 	if in.Gzip != nil {
 		in, out := &in.Gzip, &out.Gzip
 		*out = new(GzipCompressor)
-		**out = **in
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Brotli != nil {
+		in, out := &in.Brotli, &out.Brotli
+		*out = new(BrotliCompressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zstd != nil {
+		in, out := &in.Zstd, &out.Zstd
+		*out = new(ZstdCompressor)
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -1058,12 +1574,37 @@ func (in *Compression) DeepCopy() *Compression {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CompressionSettings) DeepCopyInto(out *CompressionSettings) {
+	*out = *in
+	if in.MinContentLength != nil {
+		in, out := &in.MinContentLength, &out.MinContentLength
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CompressionSettings.
+func (in *CompressionSettings) DeepCopy() *CompressionSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(CompressionSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionLimit) DeepCopyInto(out *ConnectionLimit) {
 	*out = *in
 	if in.CloseDelay != nil {
 		in, out := &in.CloseDelay, &out.CloseDelay
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -1113,7 +1654,7 @@ func (in *Cookie) DeepCopyInto(out *Cookie) {
 	*out = *in
 	if in.TTL != nil {
 		in, out := &in.TTL, &out.TTL
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.Attributes != nil {
@@ -1191,7 +1732,7 @@ func (in *CustomResponseBody) DeepCopyInto(out *CustomResponseBody) {
 	}
 	if in.ValueRef != nil {
 		in, out := &in.ValueRef, &out.ValueRef
-		*out = new(apisv1.LocalObjectReference)
+		*out = new(v1.LocalObjectReference)
 		**out = **in
 	}
 }
@@ -1263,7 +1804,7 @@ func (in *DNS) DeepCopyInto(out *DNS) {
 	*out = *in
 	if in.DNSRefreshRate != nil {
 		in, out := &in.DNSRefreshRate, &out.DNSRefreshRate
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.RespectDNSTTL != nil {
@@ -1380,6 +1921,18 @@ func (in *EnvoyExtensionPolicySpec) DeepCopyInto(out *EnvoyExtensionPolicySpec)
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Lua != nil {
+		in, out := &in.Lua, &out.Lua
+		*out = make([]Lua, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.GRPCJSONTranscoder != nil {
+		in, out := &in.GRPCJSONTranscoder, &out.GRPCJSONTranscoder
+		*out = new(GRPCJSONTranscoder)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyExtensionPolicySpec.
@@ -1647,12 +2200,12 @@ func (in *EnvoyGatewayOpenTelemetrySink) DeepCopyInto(out *EnvoyGatewayOpenTelem
 	*out = *in
 	if in.ExportInterval != nil {
 		in, out := &in.ExportInterval, &out.ExportInterval
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.ExportTimeout != nil {
 		in, out := &in.ExportTimeout, &out.ExportTimeout
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -2145,6 +2698,16 @@ func (in *ExtAuth) DeepCopyInto(out *ExtAuth) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ContextExtensions != nil {
+		in, out := &in.ContextExtensions, &out.ContextExtensions
+		*out = make([]ExtAuthContextExtension, len(*in))
+		copy(*out, *in)
+	}
+	if in.DecisionCache != nil {
+		in, out := &in.DecisionCache, &out.DecisionCache
+		*out = new(ExtAuthDecisionCache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuth.
@@ -2157,13 +2720,58 @@ func (in *ExtAuth) DeepCopy() *ExtAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthContextExtension) DeepCopyInto(out *ExtAuthContextExtension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthContextExtension.
+func (in *ExtAuthContextExtension) DeepCopy() *ExtAuthContextExtension {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthContextExtension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthDecisionCache) DeepCopyInto(out *ExtAuthDecisionCache) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxEntries != nil {
+		in, out := &in.MaxEntries, &out.MaxEntries
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthDecisionCache.
+func (in *ExtAuthDecisionCache) DeepCopy() *ExtAuthDecisionCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthDecisionCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtProc) DeepCopyInto(out *ExtProc) {
 	*out = *in
 	in.BackendCluster.DeepCopyInto(&out.BackendCluster)
 	if in.MessageTimeout != nil {
 		in, out := &in.MessageTimeout, &out.MessageTimeout
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.FailOpen != nil {
@@ -2320,6 +2928,36 @@ func (in *ExtensionTLS) DeepCopy() *ExtensionTLS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtractFrom) DeepCopyInto(out *ExtractFrom) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtractFrom.
+func (in *ExtractFrom) DeepCopy() *ExtractFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtractFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FQDNEndpoint) DeepCopyInto(out *FQDNEndpoint) {
 	*out = *in
@@ -2395,7 +3033,7 @@ func (in *FaultInjectionDelay) DeepCopyInto(out *FaultInjectionDelay) {
 	*out = *in
 	if in.FixedDelay != nil {
 		in, out := &in.FixedDelay, &out.FixedDelay
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.Percentage != nil {
@@ -2486,7 +3124,73 @@ func (in *GRPCExtAuthService) DeepCopy() *GRPCExtAuthService {
 	if in == nil {
 		return nil
 	}
-	out := new(GRPCExtAuthService)
+	out := new(GRPCExtAuthService)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoder) DeepCopyInto(out *GRPCJSONTranscoder) {
+	*out = *in
+	out.ProtoDescriptorSet = in.ProtoDescriptorSet
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrintOptions != nil {
+		in, out := &in.PrintOptions, &out.PrintOptions
+		*out = new(GRPCJSONTranscoderPrintOptions)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PassthroughUnknownPaths != nil {
+		in, out := &in.PassthroughUnknownPaths, &out.PassthroughUnknownPaths
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoder.
+func (in *GRPCJSONTranscoder) DeepCopy() *GRPCJSONTranscoder {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoderPrintOptions) DeepCopyInto(out *GRPCJSONTranscoderPrintOptions) {
+	*out = *in
+	if in.AddWhitespace != nil {
+		in, out := &in.AddWhitespace, &out.AddWhitespace
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlwaysPrintPrimitiveFields != nil {
+		in, out := &in.AlwaysPrintPrimitiveFields, &out.AlwaysPrintPrimitiveFields
+		*out = new(bool)
+		**out = **in
+	}
+	if in.AlwaysPrintEnumsAsInts != nil {
+		in, out := &in.AlwaysPrintEnumsAsInts, &out.AlwaysPrintEnumsAsInts
+		*out = new(bool)
+		**out = **in
+	}
+	if in.PreserveProtoFieldNames != nil {
+		in, out := &in.PreserveProtoFieldNames, &out.PreserveProtoFieldNames
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoderPrintOptions.
+func (in *GRPCJSONTranscoderPrintOptions) DeepCopy() *GRPCJSONTranscoderPrintOptions {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoderPrintOptions)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -2546,6 +3250,21 @@ func (in *GroupVersionKind) DeepCopy() *GroupVersionKind {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GzipCompressor) DeepCopyInto(out *GzipCompressor) {
 	*out = *in
+	if in.CompressionLevel != nil {
+		in, out := &in.CompressionLevel, &out.CompressionLevel
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MemoryLevel != nil {
+		in, out := &in.MemoryLevel, &out.MemoryLevel
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.WindowBits != nil {
+		in, out := &in.WindowBits, &out.WindowBits
+		*out = new(uint32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GzipCompressor.
@@ -2558,6 +3277,77 @@ func (in *GzipCompressor) DeepCopy() *GzipCompressor {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACAuth) DeepCopyInto(out *HMACAuth) {
+	*out = *in
+	in.SecretRef.DeepCopyInto(&out.SecretRef)
+	if in.SignaturePrefix != nil {
+		in, out := &in.SignaturePrefix, &out.SignaturePrefix
+		*out = new(string)
+		**out = **in
+	}
+	if in.Algorithm != nil {
+		in, out := &in.Algorithm, &out.Algorithm
+		*out = new(HMACAlgorithm)
+		**out = **in
+	}
+	if in.Encoding != nil {
+		in, out := &in.Encoding, &out.Encoding
+		*out = new(HMACSignatureEncoding)
+		**out = **in
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(HMACTimestamp)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxRequestBytes != nil {
+		in, out := &in.MaxRequestBytes, &out.MaxRequestBytes
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACAuth.
+func (in *HMACAuth) DeepCopy() *HMACAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACTimestamp) DeepCopyInto(out *HMACTimestamp) {
+	*out = *in
+	if in.MaxSkew != nil {
+		in, out := &in.MaxSkew, &out.MaxSkew
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Separator != nil {
+		in, out := &in.Separator, &out.Separator
+		*out = new(string)
+		**out = **in
+	}
+	if in.PayloadPrefix != nil {
+		in, out := &in.PayloadPrefix, &out.PayloadPrefix
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACTimestamp.
+func (in *HMACTimestamp) DeepCopy() *HMACTimestamp {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACTimestamp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTP10Settings) DeepCopyInto(out *HTTP10Settings) {
 	*out = *in
@@ -2693,12 +3483,12 @@ func (in *HTTPClientTimeout) DeepCopyInto(out *HTTPClientTimeout) {
 	*out = *in
 	if in.RequestReceivedTimeout != nil {
 		in, out := &in.RequestReceivedTimeout, &out.RequestReceivedTimeout
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.IdleTimeout != nil {
 		in, out := &in.IdleTimeout, &out.IdleTimeout
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -2713,6 +3503,32 @@ func (in *HTTPClientTimeout) DeepCopy() *HTTPClientTimeout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HTTPCredentialInjectionFilter) DeepCopyInto(out *HTTPCredentialInjectionFilter) {
+	*out = *in
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.Overwrite != nil {
+		in, out := &in.Overwrite, &out.Overwrite
+		*out = new(bool)
+		**out = **in
+	}
+	in.Credential.DeepCopyInto(&out.Credential)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPCredentialInjectionFilter.
+func (in *HTTPCredentialInjectionFilter) DeepCopy() *HTTPCredentialInjectionFilter {
+	if in == nil {
+		return nil
+	}
+	out := new(HTTPCredentialInjectionFilter)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTPDirectResponseFilter) DeepCopyInto(out *HTTPDirectResponseFilter) {
 	*out = *in
@@ -2880,6 +3696,11 @@ func (in *HTTPRouteFilterSpec) DeepCopyInto(out *HTTPRouteFilterSpec) {
 		*out = new(HTTPDirectResponseFilter)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CredentialInjection != nil {
+		in, out := &in.CredentialInjection, &out.CredentialInjection
+		*out = new(HTTPCredentialInjectionFilter)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HTTPRouteFilterSpec.
@@ -2897,17 +3718,17 @@ func (in *HTTPTimeout) DeepCopyInto(out *HTTPTimeout) {
 	*out = *in
 	if in.ConnectionIdleTimeout != nil {
 		in, out := &in.ConnectionIdleTimeout, &out.ConnectionIdleTimeout
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.MaxConnectionDuration != nil {
 		in, out := &in.MaxConnectionDuration, &out.MaxConnectionDuration
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.RequestTimeout != nil {
 		in, out := &in.RequestTimeout, &out.RequestTimeout
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -3042,7 +3863,7 @@ func (in *HeaderSettings) DeepCopyInto(out *HeaderSettings) {
 	}
 	if in.EarlyRequestHeaders != nil {
 		in, out := &in.EarlyRequestHeaders, &out.EarlyRequestHeaders
-		*out = new(apisv1.HTTPHeaderFilter)
+		*out = new(v1.HTTPHeaderFilter)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -3122,7 +3943,7 @@ func (in *ImageWasmCodeSource) DeepCopyInto(out *ImageWasmCodeSource) {
 	}
 	if in.PullSecretRef != nil {
 		in, out := &in.PullSecretRef, &out.PullSecretRef
-		*out = new(apisv1.SecretObjectReference)
+		*out = new(v1.SecretObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -3137,6 +3958,22 @@ func (in *ImageWasmCodeSource) DeepCopy() *ImageWasmCodeSource {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *InjectedCredential) DeepCopyInto(out *InjectedCredential) {
+	*out = *in
+	in.ValueRef.DeepCopyInto(&out.ValueRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InjectedCredential.
+func (in *InjectedCredential) DeepCopy() *InjectedCredential {
+	if in == nil {
+		return nil
+	}
+	out := new(InjectedCredential)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JSONPatchOperation) DeepCopyInto(out *JSONPatchOperation) {
 	*out = *in
@@ -3224,6 +4061,31 @@ func (in *JWTClaim) DeepCopy() *JWTClaim {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTClaimMatch) DeepCopyInto(out *JWTClaimMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(JWTClaimMatchType)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTClaimMatch.
+func (in *JWTClaimMatch) DeepCopy() *JWTClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *JWTExtractor) DeepCopyInto(out *JWTExtractor) {
 	*out = *in
@@ -3311,7 +4173,16 @@ func (in *JWTProvider) DeepCopyInto(out *JWTProvider) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	out.RemoteJWKS = in.RemoteJWKS
+	if in.RemoteJWKS != nil {
+		in, out := &in.RemoteJWKS, &out.RemoteJWKS
+		*out = new(RemoteJWKS)
+		**out = **in
+	}
+	if in.LocalJWKS != nil {
+		in, out := &in.LocalJWKS, &out.LocalJWKS
+		*out = new(LocalJWKS)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ClaimToHeaders != nil {
 		in, out := &in.ClaimToHeaders, &out.ClaimToHeaders
 		*out = make([]ClaimToHeader, len(*in))
@@ -3729,7 +4600,7 @@ func (in *KubernetesWatchMode) DeepCopyInto(out *KubernetesWatchMode) {
 	}
 	if in.NamespaceSelector != nil {
 		in, out := &in.NamespaceSelector, &out.NamespaceSelector
-		*out = new(v1.LabelSelector)
+		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -3749,17 +4620,17 @@ func (in *LeaderElection) DeepCopyInto(out *LeaderElection) {
 	*out = *in
 	if in.LeaseDuration != nil {
 		in, out := &in.LeaseDuration, &out.LeaseDuration
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.RenewDeadline != nil {
 		in, out := &in.RenewDeadline, &out.RenewDeadline
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.RetryPeriod != nil {
 		in, out := &in.RetryPeriod, &out.RetryPeriod
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Disable != nil {
@@ -3807,6 +4678,11 @@ func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 		*out = new(SlowStart)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ZoneAware != nil {
+		in, out := &in.ZoneAware, &out.ZoneAware
+		*out = new(ZoneAware)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
@@ -3819,6 +4695,36 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalJWKS) DeepCopyInto(out *LocalJWKS) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(LocalJWKSType)
+		**out = **in
+	}
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueRef != nil {
+		in, out := &in.ValueRef, &out.ValueRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalJWKS.
+func (in *LocalJWKS) DeepCopy() *LocalJWKS {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalJWKS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LocalRateLimit) DeepCopyInto(out *LocalRateLimit) {
 	*out = *in
@@ -3841,6 +4747,68 @@ func (in *LocalRateLimit) DeepCopy() *LocalRateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Lua) DeepCopyInto(out *Lua) {
+	*out = *in
+	if in.Inline != nil {
+		in, out := &in.Inline, &out.Inline
+		*out = new(string)
+		**out = **in
+	}
+	if in.ValueRef != nil {
+		in, out := &in.ValueRef, &out.ValueRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.FailOpen != nil {
+		in, out := &in.FailOpen, &out.FailOpen
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lua.
+func (in *Lua) DeepCopy() *Lua {
+	if in == nil {
+		return nil
+	}
+	out := new(Lua)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ClientCredentials) DeepCopyInto(out *OAuth2ClientCredentials) {
+	*out = *in
+	in.BackendCluster.DeepCopyInto(&out.BackendCluster)
+	in.ClientSecret.DeepCopyInto(&out.ClientSecret)
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AuthenticationMethod != nil {
+		in, out := &in.AuthenticationMethod, &out.AuthenticationMethod
+		*out = new(OAuth2ClientAuthenticationMethod)
+		**out = **in
+	}
+	if in.TokenFetchRetryInterval != nil {
+		in, out := &in.TokenFetchRetryInterval, &out.TokenFetchRetryInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientCredentials.
+func (in *OAuth2ClientCredentials) DeepCopy() *OAuth2ClientCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDC) DeepCopyInto(out *OIDC) {
 	*out = *in
@@ -3883,7 +4851,7 @@ func (in *OIDC) DeepCopyInto(out *OIDC) {
 	}
 	if in.DefaultTokenTTL != nil {
 		in, out := &in.DefaultTokenTTL, &out.DefaultTokenTTL
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.RefreshToken != nil {
@@ -3893,7 +4861,7 @@ func (in *OIDC) DeepCopyInto(out *OIDC) {
 	}
 	if in.DefaultRefreshTokenTTL != nil {
 		in, out := &in.DefaultRefreshTokenTTL, &out.DefaultRefreshTokenTTL
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
@@ -3987,6 +4955,54 @@ func (in *OpenTelemetryEnvoyProxyAccessLog) DeepCopy() *OpenTelemetryEnvoyProxyA
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Operation) DeepCopyInto(out *Operation) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]v1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]AuthorizationHeaderMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ServerNames != nil {
+		in, out := &in.ServerNames, &out.ServerNames
+		*out = make([]StringMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Operation.
+func (in *Operation) DeepCopy() *Operation {
+	if in == nil {
+		return nil
+	}
+	out := new(Operation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PassiveHealthCheck) DeepCopyInto(out *PassiveHealthCheck) {
 	*out = *in
@@ -3997,7 +5013,7 @@ func (in *PassiveHealthCheck) DeepCopyInto(out *PassiveHealthCheck) {
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.ConsecutiveLocalOriginFailures != nil {
@@ -4017,7 +5033,7 @@ func (in *PassiveHealthCheck) DeepCopyInto(out *PassiveHealthCheck) {
 	}
 	if in.BaseEjectionTime != nil {
 		in, out := &in.BaseEjectionTime, &out.BaseEjectionTime
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.MaxEjectionPercent != nil {
@@ -4067,7 +5083,7 @@ func (in *PerRetryPolicy) DeepCopyInto(out *PerRetryPolicy) {
 	*out = *in
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.BackOff != nil {
@@ -4075,6 +5091,11 @@ func (in *PerRetryPolicy) DeepCopyInto(out *PerRetryPolicy) {
 		*out = new(BackOffPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.HedgeOnTimeout != nil {
+		in, out := &in.HedgeOnTimeout, &out.HedgeOnTimeout
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerRetryPolicy.
@@ -4121,6 +5142,26 @@ func (in *PolicyTargetReferences) DeepCopy() *PolicyTargetReferences {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferLocalZone) DeepCopyInto(out *PreferLocalZone) {
+	*out = *in
+	if in.MinEndpointsThreshold != nil {
+		in, out := &in.MinEndpointsThreshold, &out.MinEndpointsThreshold
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferLocalZone.
+func (in *PreferLocalZone) DeepCopy() *PreferLocalZone {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferLocalZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Principal) DeepCopyInto(out *Principal) {
 	*out = *in
@@ -4129,11 +5170,23 @@ func (in *Principal) DeepCopyInto(out *Principal) {
 		*out = make([]CIDR, len(*in))
 		copy(*out, *in)
 	}
+	if in.ClientCIDRsFrom != nil {
+		in, out := &in.ClientCIDRsFrom, &out.ClientCIDRsFrom
+		*out = make([]CIDRListSource, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.JWT != nil {
 		in, out := &in.JWT, &out.JWT
 		*out = new(JWTPrincipal)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(ClientCertificatePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Principal.
@@ -4171,6 +5224,22 @@ func (in *ProcessingModeOptions) DeepCopy() *ProcessingModeOptions {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ProtoDescriptorSet) DeepCopyInto(out *ProtoDescriptorSet) {
+	*out = *in
+	out.ValueRef = in.ValueRef
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ProtoDescriptorSet.
+func (in *ProtoDescriptorSet) DeepCopy() *ProtoDescriptorSet {
+	if in == nil {
+		return nil
+	}
+	out := new(ProtoDescriptorSet)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ProxyAccessLog) DeepCopyInto(out *ProxyAccessLog) {
 	*out = *in
@@ -4524,13 +5593,43 @@ func (in *ProxyTracing) DeepCopy() *ProxyTracing {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *QueryParamMatch) DeepCopyInto(out *QueryParamMatch) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(QueryParamMatchType)
+		**out = **in
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+	if in.Invert != nil {
+		in, out := &in.Invert, &out.Invert
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new QueryParamMatch.
+func (in *QueryParamMatch) DeepCopy() *QueryParamMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(QueryParamMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimit) DeepCopyInto(out *RateLimit) {
 	*out = *in
 	in.Backend.DeepCopyInto(&out.Backend)
 	if in.Timeout != nil {
 		in, out := &in.Timeout, &out.Timeout
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.Telemetry != nil {
@@ -4550,6 +5649,76 @@ func (in *RateLimit) DeepCopy() *RateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitCost) DeepCopyInto(out *RateLimitCost) {
+	*out = *in
+	if in.Request != nil {
+		in, out := &in.Request, &out.Request
+		*out = new(RateLimitCostSpecifier)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Response != nil {
+		in, out := &in.Response, &out.Response
+		*out = new(RateLimitCostSpecifier)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitCost.
+func (in *RateLimitCost) DeepCopy() *RateLimitCost {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitCost)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitCostMetadata) DeepCopyInto(out *RateLimitCostMetadata) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitCostMetadata.
+func (in *RateLimitCostMetadata) DeepCopy() *RateLimitCostMetadata {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitCostMetadata)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitCostSpecifier) DeepCopyInto(out *RateLimitCostSpecifier) {
+	*out = *in
+	if in.Number != nil {
+		in, out := &in.Number, &out.Number
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Header != nil {
+		in, out := &in.Header, &out.Header
+		*out = new(string)
+		**out = **in
+	}
+	if in.Metadata != nil {
+		in, out := &in.Metadata, &out.Metadata
+		*out = new(RateLimitCostMetadata)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitCostSpecifier.
+func (in *RateLimitCostSpecifier) DeepCopy() *RateLimitCostSpecifier {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitCostSpecifier)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitDatabaseBackend) DeepCopyInto(out *RateLimitDatabaseBackend) {
 	*out = *in
@@ -4558,6 +5727,11 @@ func (in *RateLimitDatabaseBackend) DeepCopyInto(out *RateLimitDatabaseBackend)
 		*out = new(RateLimitRedisSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Memcached != nil {
+		in, out := &in.Memcached, &out.Memcached
+		*out = new(RateLimitMemcachedSettings)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitDatabaseBackend.
@@ -4570,6 +5744,31 @@ func (in *RateLimitDatabaseBackend) DeepCopy() *RateLimitDatabaseBackend {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitMemcachedSettings) DeepCopyInto(out *RateLimitMemcachedSettings) {
+	*out = *in
+	if in.Addresses != nil {
+		in, out := &in.Addresses, &out.Addresses
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MaxIdleConns != nil {
+		in, out := &in.MaxIdleConns, &out.MaxIdleConns
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitMemcachedSettings.
+func (in *RateLimitMemcachedSettings) DeepCopy() *RateLimitMemcachedSettings {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitMemcachedSettings)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitMetrics) DeepCopyInto(out *RateLimitMetrics) {
 	*out = *in
@@ -4608,11 +5807,31 @@ func (in *RateLimitMetricsPrometheusProvider) DeepCopy() *RateLimitMetricsPromet
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitRedisSettings) DeepCopyInto(out *RateLimitRedisSettings) {
 	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(RateLimitRedisType)
+		**out = **in
+	}
 	if in.TLS != nil {
 		in, out := &in.TLS, &out.TLS
 		*out = new(RedisTLSSettings)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(RedisAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.PoolSize != nil {
+		in, out := &in.PoolSize, &out.PoolSize
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Pipeline != nil {
+		in, out := &in.Pipeline, &out.Pipeline
+		*out = new(RedisPipeline)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRedisSettings.
@@ -4636,6 +5855,11 @@ func (in *RateLimitRule) DeepCopyInto(out *RateLimitRule) {
 		}
 	}
 	out.Limit = in.Limit
+	if in.Cost != nil {
+		in, out := &in.Cost, &out.Cost
+		*out = new(RateLimitCost)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRule.
@@ -4663,6 +5887,30 @@ func (in *RateLimitSelectCondition) DeepCopyInto(out *RateLimitSelectCondition)
 		*out = new(SourceMatch)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]v1.HTTPMethod, len(*in))
+		copy(*out, *in)
+	}
+	if in.Path != nil {
+		in, out := &in.Path, &out.Path
+		*out = new(StringMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryParams != nil {
+		in, out := &in.QueryParams, &out.QueryParams
+		*out = make([]QueryParamMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.JWTClaims != nil {
+		in, out := &in.JWTClaims, &out.JWTClaims
+		*out = make([]JWTClaimMatch, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSelectCondition.
@@ -4688,6 +5936,11 @@ func (in *RateLimitSpec) DeepCopyInto(out *RateLimitSpec) {
 		*out = new(LocalRateLimit)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ShadowMode != nil {
+		in, out := &in.ShadowMode, &out.ShadowMode
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitSpec.
@@ -4785,12 +6038,58 @@ func (in *RateLimitValue) DeepCopy() *RateLimitValue {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisAuth) DeepCopyInto(out *RedisAuth) {
+	*out = *in
+	if in.Username != nil {
+		in, out := &in.Username, &out.Username
+		*out = new(string)
+		**out = **in
+	}
+	in.PasswordRef.DeepCopyInto(&out.PasswordRef)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisAuth.
+func (in *RedisAuth) DeepCopy() *RedisAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RedisPipeline) DeepCopyInto(out *RedisPipeline) {
+	*out = *in
+	if in.Window != nil {
+		in, out := &in.Window, &out.Window
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Limit != nil {
+		in, out := &in.Limit, &out.Limit
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RedisPipeline.
+func (in *RedisPipeline) DeepCopy() *RedisPipeline {
+	if in == nil {
+		return nil
+	}
+	out := new(RedisPipeline)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RedisTLSSettings) DeepCopyInto(out *RedisTLSSettings) {
 	*out = *in
 	if in.CertificateRef != nil {
 		in, out := &in.CertificateRef, &out.CertificateRef
-		*out = new(apisv1.SecretObjectReference)
+		*out = new(v1.SecretObjectReference)
 		(*in).DeepCopyInto(*out)
 	}
 }
@@ -4835,6 +6134,22 @@ func (in *ReplaceRegexMatch) DeepCopy() *ReplaceRegexMatch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestBuffer) DeepCopyInto(out *RequestBuffer) {
+	*out = *in
+	out.Limit = in.Limit.DeepCopy()
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestBuffer.
+func (in *RequestBuffer) DeepCopy() *RequestBuffer {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestBuffer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RequestHeaderCustomTag) DeepCopyInto(out *RequestHeaderCustomTag) {
 	*out = *in
@@ -4890,6 +6205,11 @@ func (in *Retry) DeepCopyInto(out *Retry) {
 		*out = new(PerRetryPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(RetryBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
@@ -4902,6 +6222,31 @@ func (in *Retry) DeepCopy() *Retry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBudget) DeepCopyInto(out *RetryBudget) {
+	*out = *in
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinConcurrency != nil {
+		in, out := &in.MinConcurrency, &out.MinConcurrency
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudget.
+func (in *RetryBudget) DeepCopy() *RetryBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryOn) DeepCopyInto(out *RetryOn) {
 	*out = *in
@@ -4995,11 +6340,26 @@ func (in *SecurityPolicySpec) DeepCopyInto(out *SecurityPolicySpec) {
 		*out = new(CORS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CSRF != nil {
+		in, out := &in.CSRF, &out.CSRF
+		*out = new(CSRF)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.BasicAuth != nil {
 		in, out := &in.BasicAuth, &out.BasicAuth
 		*out = new(BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIKeyAuth != nil {
+		in, out := &in.APIKeyAuth, &out.APIKeyAuth
+		*out = new(APIKeyAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HMACAuth != nil {
+		in, out := &in.HMACAuth, &out.HMACAuth
+		*out = new(HMACAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.JWT != nil {
 		in, out := &in.JWT, &out.JWT
 		*out = new(JWT)
@@ -5082,12 +6442,12 @@ func (in *ShutdownConfig) DeepCopyInto(out *ShutdownConfig) {
 	*out = *in
 	if in.DrainTimeout != nil {
 		in, out := &in.DrainTimeout, &out.DrainTimeout
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 	if in.MinDrainDuration != nil {
 		in, out := &in.MinDrainDuration, &out.MinDrainDuration
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
@@ -5127,7 +6487,7 @@ func (in *SlowStart) DeepCopyInto(out *SlowStart) {
 	*out = *in
 	if in.Window != nil {
 		in, out := &in.Window, &out.Window
-		*out = new(v1.Duration)
+		*out = new(metav1.Duration)
 		**out = **in
 	}
 }
@@ -5287,7 +6647,7 @@ func (in *TCPClientTimeout) DeepCopyInto(out *TCPClientTimeout) {
 	*out = *in
 	if in.IdleTimeout != nil {
 		in, out := &in.IdleTimeout, &out.IdleTimeout
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -5312,12 +6672,12 @@ func (in *TCPKeepalive) DeepCopyInto(out *TCPKeepalive) {
 	}
 	if in.IdleTime != nil {
 		in, out := &in.IdleTime, &out.IdleTime
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 	if in.Interval != nil {
 		in, out := &in.Interval, &out.Interval
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -5337,7 +6697,7 @@ func (in *TCPTimeout) DeepCopyInto(out *TCPTimeout) {
 	*out = *in
 	if in.ConnectTimeout != nil {
 		in, out := &in.ConnectTimeout, &out.ConnectTimeout
-		*out = new(apisv1.Duration)
+		*out = new(v1.Duration)
 		**out = **in
 	}
 }
@@ -5402,7 +6762,7 @@ func (in *TargetSelector) DeepCopyInto(out *TargetSelector) {
 	*out = *in
 	if in.Group != nil {
 		in, out := &in.Group, &out.Group
-		*out = new(apisv1.Group)
+		*out = new(v1.Group)
 		**out = **in
 	}
 	if in.MatchLabels != nil {
@@ -5581,6 +6941,21 @@ func (in *WasmEnv) DeepCopy() *WasmEnv {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *WeightedZone) DeepCopyInto(out *WeightedZone) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new WeightedZone.
+func (in *WeightedZone) DeepCopy() *WeightedZone {
+	if in == nil {
+		return nil
+	}
+	out := new(WeightedZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *XDSTranslatorHooks) DeepCopyInto(out *XDSTranslatorHooks) {
 	*out = *in
@@ -5680,3 +7055,53 @@ func (in *ZipkinTracingProvider) DeepCopy() *ZipkinTracingProvider {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneAware) DeepCopyInto(out *ZoneAware) {
+	*out = *in
+	if in.PreferLocal != nil {
+		in, out := &in.PreferLocal, &out.PreferLocal
+		*out = new(PreferLocalZone)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WeightedZones != nil {
+		in, out := &in.WeightedZones, &out.WeightedZones
+		*out = make([]WeightedZone, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneAware.
+func (in *ZoneAware) DeepCopy() *ZoneAware {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneAware)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZstdCompressor) DeepCopyInto(out *ZstdCompressor) {
+	*out = *in
+	if in.CompressionLevel != nil {
+		in, out := &in.CompressionLevel, &out.CompressionLevel
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.EnableChecksum != nil {
+		in, out := &in.EnableChecksum, &out.EnableChecksum
+		*out = new(bool)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZstdCompressor.
+func (in *ZstdCompressor) DeepCopy() *ZstdCompressor {
+	if in == nil {
+		return nil
+	}
+	out := new(ZstdCompressor)
+	in.DeepCopyInto(out)
+	return out
+}
@@ -190,7 +190,7 @@ func checkEnableGlobalRateLimit(cli kubernetes.CLIClient) (bool, error) {
 		return false, errors.New("failed to convert object to EnvoyGateway type")
 	}
 
-	if eg.RateLimit == nil || eg.RateLimit.Backend.Redis == nil {
+	if eg.RateLimit == nil || (eg.RateLimit.Backend.Redis == nil && eg.RateLimit.Backend.Memcached == nil) {
 		return false, nil
 	}
 
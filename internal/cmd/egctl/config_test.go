@@ -403,6 +403,32 @@ rateLimit:
     type: Redis
     redis:
       url: redis.redis-system.svc.cluster.local:6379
+`,
+				},
+			},
+		},
+		{
+			caseName: "global rate limit feature is enabled with memcached",
+			expect:   true,
+			egConfigMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "envoy-gateway-config",
+					Namespace: "envoy-gateway-system",
+				},
+				Data: map[string]string{
+					"envoy-gateway.yaml": `
+apiVersion: gateway.envoyproxy.io/v1alpha1
+kind: EnvoyGateway
+provider:
+  type: Kubernetes
+gateway:
+  controllerName: gateway.envoyproxy.io/gatewayclass-controller
+rateLimit:
+  backend:
+    type: Memcached
+    memcached:
+      addresses:
+      - memcached.memcached-system.svc.cluster.local:11211
 `,
 				},
 			},
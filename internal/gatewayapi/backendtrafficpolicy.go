@@ -9,15 +9,19 @@ import (
 	"errors"
 	"fmt"
 	"math"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	perr "github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/utils/ptr"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
 	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
@@ -305,6 +309,13 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 		ds        *ir.DNS
 		h2        *ir.HTTP2Settings
 		ro        *ir.ResponseOverride
+		cc        *ir.OAuth2ClientCredentials
+		ac        *ir.AdaptiveConcurrency
+		adc       *ir.AdmissionControl
+		cp        []*ir.Compression
+		ca        *ir.Cache
+		rb        *ir.RequestBuffer
+		bl        *ir.BandwidthLimit
 		err, errs error
 	)
 
@@ -355,6 +366,41 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 		errs = errors.Join(errs, err)
 	}
 
+	if policy.Spec.OAuth2ClientCredentials != nil {
+		var envoyProxy *egv1a1.EnvoyProxy
+		for _, p := range GetParentReferences(route) {
+			if gtwCtx := GetRouteParentContext(route, p).GetGateway(); gtwCtx != nil {
+				envoyProxy = gtwCtx.envoyProxy // Only the last EnvoyProxy is used
+			}
+		}
+		if cc, err = t.buildOAuth2ClientCredentials(policy, resources, envoyProxy); err != nil {
+			err = perr.WithMessage(err, "OAuth2ClientCredentials")
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	if ac, err = buildAdaptiveConcurrency(policy.Spec.AdaptiveConcurrency); err != nil {
+		err = perr.WithMessage(err, "AdaptiveConcurrency")
+		errs = errors.Join(errs, err)
+	}
+	if adc, err = buildAdmissionControl(policy.Spec.AdmissionControl); err != nil {
+		err = perr.WithMessage(err, "AdmissionControl")
+		errs = errors.Join(errs, err)
+	}
+	cp = buildCompression(policy.Spec.Compression, policy.Spec.CompressionSettings)
+	if ca, err = buildCache(policy.Spec.Cache); err != nil {
+		err = perr.WithMessage(err, "Cache")
+		errs = errors.Join(errs, err)
+	}
+	if rb, err = buildRequestBuffer(policy.Spec.RequestBuffer); err != nil {
+		err = perr.WithMessage(err, "RequestBuffer")
+		errs = errors.Join(errs, err)
+	}
+	if bl, err = buildBandwidthLimit(policy.Spec.BandwidthLimit); err != nil {
+		err = perr.WithMessage(err, "BandwidthLimit")
+		errs = errors.Join(errs, err)
+	}
+
 	ds = translateDNS(policy.Spec.ClusterSettings)
 
 	// Apply IR to all relevant routes
@@ -404,19 +450,26 @@ func (t *Translator) translateBackendTrafficPolicyForRoute(
 					}
 
 					r.Traffic = &ir.TrafficFeatures{
-						RateLimit:         rl,
-						LoadBalancer:      lb,
-						ProxyProtocol:     pp,
-						HealthCheck:       hc,
-						CircuitBreaker:    cb,
-						FaultInjection:    fi,
-						TCPKeepalive:      ka,
-						Retry:             rt,
-						BackendConnection: bc,
-						HTTP2:             h2,
-						DNS:               ds,
-						Timeout:           to,
-						ResponseOverride:  ro,
+						RateLimit:               rl,
+						LoadBalancer:            lb,
+						ProxyProtocol:           pp,
+						HealthCheck:             hc,
+						CircuitBreaker:          cb,
+						FaultInjection:          fi,
+						TCPKeepalive:            ka,
+						Retry:                   rt,
+						BackendConnection:       bc,
+						HTTP2:                   h2,
+						DNS:                     ds,
+						Timeout:                 to,
+						ResponseOverride:        ro,
+						OAuth2ClientCredentials: cc,
+						AdaptiveConcurrency:     ac,
+						AdmissionControl:        adc,
+						Compression:             cp,
+						Cache:                   ca,
+						RequestBuffer:           rb,
+						BandwidthLimit:          bl,
 					}
 
 					// Update the Host field in HealthCheck, now that we have access to the Route Hostname.
@@ -453,6 +506,13 @@ func (t *Translator) translateBackendTrafficPolicyForGateway(
 		ds        *ir.DNS
 		h2        *ir.HTTP2Settings
 		ro        *ir.ResponseOverride
+		cc        *ir.OAuth2ClientCredentials
+		ac        *ir.AdaptiveConcurrency
+		adc       *ir.AdmissionControl
+		cp        []*ir.Compression
+		ca        *ir.Cache
+		rb        *ir.RequestBuffer
+		bl        *ir.BandwidthLimit
 		err, errs error
 	)
 
@@ -495,6 +555,34 @@ func (t *Translator) translateBackendTrafficPolicyForGateway(
 		err = perr.WithMessage(err, "ResponseOverride")
 		errs = errors.Join(errs, err)
 	}
+	if policy.Spec.OAuth2ClientCredentials != nil {
+		if cc, err = t.buildOAuth2ClientCredentials(policy, resources, gateway.envoyProxy); err != nil {
+			err = perr.WithMessage(err, "OAuth2ClientCredentials")
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	if ac, err = buildAdaptiveConcurrency(policy.Spec.AdaptiveConcurrency); err != nil {
+		err = perr.WithMessage(err, "AdaptiveConcurrency")
+		errs = errors.Join(errs, err)
+	}
+	if adc, err = buildAdmissionControl(policy.Spec.AdmissionControl); err != nil {
+		err = perr.WithMessage(err, "AdmissionControl")
+		errs = errors.Join(errs, err)
+	}
+	cp = buildCompression(policy.Spec.Compression, policy.Spec.CompressionSettings)
+	if ca, err = buildCache(policy.Spec.Cache); err != nil {
+		err = perr.WithMessage(err, "Cache")
+		errs = errors.Join(errs, err)
+	}
+	if rb, err = buildRequestBuffer(policy.Spec.RequestBuffer); err != nil {
+		err = perr.WithMessage(err, "RequestBuffer")
+		errs = errors.Join(errs, err)
+	}
+	if bl, err = buildBandwidthLimit(policy.Spec.BandwidthLimit); err != nil {
+		err = perr.WithMessage(err, "BandwidthLimit")
+		errs = errors.Join(errs, err)
+	}
 
 	ds = translateDNS(policy.Spec.ClusterSettings)
 
@@ -568,17 +656,24 @@ func (t *Translator) translateBackendTrafficPolicyForGateway(
 			}
 
 			r.Traffic = &ir.TrafficFeatures{
-				RateLimit:        rl,
-				LoadBalancer:     lb,
-				ProxyProtocol:    pp,
-				HealthCheck:      hc,
-				CircuitBreaker:   cb,
-				FaultInjection:   fi,
-				TCPKeepalive:     ka,
-				Retry:            rt,
-				HTTP2:            h2,
-				DNS:              ds,
-				ResponseOverride: ro,
+				RateLimit:               rl,
+				LoadBalancer:            lb,
+				ProxyProtocol:           pp,
+				HealthCheck:             hc,
+				CircuitBreaker:          cb,
+				FaultInjection:          fi,
+				TCPKeepalive:            ka,
+				Retry:                   rt,
+				HTTP2:                   h2,
+				DNS:                     ds,
+				ResponseOverride:        ro,
+				OAuth2ClientCredentials: cc,
+				AdaptiveConcurrency:     ac,
+				AdmissionControl:        adc,
+				Compression:             cp,
+				Cache:                   ca,
+				RequestBuffer:           rb,
+				BandwidthLimit:          bl,
 			}
 
 			// Update the Host field in HealthCheck, now that we have access to the Route Hostname.
@@ -620,6 +715,9 @@ func (t *Translator) buildLocalRateLimit(policy *egv1a1.BackendTrafficPolicy) (*
 	// limit. If no such rule is found, EG uses a default limit of uint32 max.
 	var defaultLimit *ir.RateLimitValue
 	for _, rule := range local.Rules {
+		if rule.Cost != nil {
+			return nil, fmt.Errorf("local rateLimit does not support cost")
+		}
 		if len(rule.ClientSelectors) == 0 {
 			if defaultLimit != nil {
 				return nil, fmt.Errorf("local rateLimit can not have more than one rule without clientSelectors")
@@ -674,13 +772,26 @@ func (t *Translator) buildLocalRateLimit(policy *egv1a1.BackendTrafficPolicy) (*
 				return nil, fmt.Errorf("local rateLimit does not support distinct HeaderMatch")
 			}
 		}
+
+		for _, match := range irRule.QueryParamMatches {
+			if match.Distinct {
+				return nil, fmt.Errorf("local rateLimit does not support distinct QueryParamMatch")
+			}
+		}
+
+		for _, match := range irRule.JWTClaimMatches {
+			if match.Distinct {
+				return nil, fmt.Errorf("local rateLimit does not support distinct JWTClaimMatch")
+			}
+		}
 		irRules = append(irRules, irRule)
 	}
 
 	rateLimit := &ir.RateLimit{
 		Local: &ir.LocalRateLimit{
-			Default: *defaultLimit,
-			Rules:   irRules,
+			Default:    *defaultLimit,
+			Rules:      irRules,
+			ShadowMode: ptr.Deref(policy.Spec.RateLimit.ShadowMode, false),
 		},
 	}
 
@@ -699,7 +810,8 @@ func (t *Translator) buildGlobalRateLimit(policy *egv1a1.BackendTrafficPolicy) (
 	global := policy.Spec.RateLimit.Global
 	rateLimit := &ir.RateLimit{
 		Global: &ir.GlobalRateLimit{
-			Rules: make([]*ir.RateLimitRule, len(global.Rules)),
+			Rules:      make([]*ir.RateLimitRule, len(global.Rules)),
+			ShadowMode: ptr.Deref(policy.Spec.RateLimit.ShadowMode, false),
 		},
 	}
 
@@ -725,10 +837,11 @@ func buildRateLimitRule(rule egv1a1.RateLimitRule) (*ir.RateLimitRule, error) {
 	}
 
 	for _, match := range rule.ClientSelectors {
-		if len(match.Headers) == 0 && match.SourceCIDR == nil {
+		if len(match.Headers) == 0 && match.SourceCIDR == nil && len(match.Methods) == 0 &&
+			match.Path == nil && len(match.QueryParams) == 0 && len(match.JWTClaims) == 0 {
 			return nil, fmt.Errorf(
 				"unable to translate rateLimit. At least one of the" +
-					" header or sourceCIDR must be specified")
+					" header, sourceCIDR, method, path, queryParam or jwtClaim must be specified")
 		}
 		for _, header := range match.Headers {
 			switch {
@@ -784,10 +897,125 @@ func buildRateLimitRule(rule egv1a1.RateLimitRule) (*ir.RateLimitRule, error) {
 			cidrMatch.Distinct = distinct
 			irRule.CIDRMatch = cidrMatch
 		}
+
+		for _, method := range match.Methods {
+			irRule.MethodMatches = append(irRule.MethodMatches, string(method))
+		}
+
+		if match.Path != nil {
+			pathMatch, err := irStringMatch("", *match.Path)
+			if err != nil {
+				return nil, fmt.Errorf("unable to translate rateLimit: %w", err)
+			}
+			irRule.PathMatch = pathMatch
+		}
+
+		for _, queryParam := range match.QueryParams {
+			m, err := buildRateLimitQueryParamMatch(queryParam)
+			if err != nil {
+				return nil, err
+			}
+			irRule.QueryParamMatches = append(irRule.QueryParamMatches, m)
+		}
+
+		for _, claim := range match.JWTClaims {
+			m := &ir.JWTClaimMatch{
+				Provider: claim.Provider,
+				Claim:    strings.Split(claim.Name, "."),
+			}
+			switch ptr.Deref(claim.Type, egv1a1.JWTClaimMatchExact) {
+			case egv1a1.JWTClaimMatchExact:
+				if claim.Value == nil {
+					return nil, fmt.Errorf(
+						"unable to translate rateLimit. The jwtClaim %s is missing a value", claim.Name)
+				}
+				m.Value = claim.Value
+			case egv1a1.JWTClaimMatchDistinct:
+				m.Distinct = true
+			default:
+				return nil, fmt.Errorf(
+					"unable to translate rateLimit. The jwtClaim type %s is not valid", *claim.Type)
+			}
+			irRule.JWTClaimMatches = append(irRule.JWTClaimMatches, m)
+		}
+	}
+
+	if rule.Cost != nil {
+		var err error
+		if rule.Cost.Request != nil {
+			if irRule.RequestCost, err = buildRateLimitCost(rule.Cost.Request, "REQ"); err != nil {
+				return nil, err
+			}
+		}
+		if rule.Cost.Response != nil {
+			if irRule.ResponseCost, err = buildRateLimitCost(rule.Cost.Response, "RESP"); err != nil {
+				return nil, err
+			}
+		}
 	}
 	return irRule, nil
 }
 
+// buildRateLimitCost translates the cost specifier to the IR. The header operator
+// is "REQ" for the request headers, or "RESP" for the response headers.
+func buildRateLimitCost(cost *egv1a1.RateLimitCostSpecifier, headerOperator string) (*ir.RateLimitCost, error) {
+	switch {
+	case cost.From == egv1a1.RateLimitCostFromNumber && cost.Number != nil:
+		return &ir.RateLimitCost{
+			Number: cost.Number,
+		}, nil
+	case cost.From == egv1a1.RateLimitCostFromHeader && cost.Header != nil:
+		return &ir.RateLimitCost{
+			Format: ptr.To(fmt.Sprintf("%%%s(%s)%%", headerOperator, *cost.Header)),
+		}, nil
+	case cost.From == egv1a1.RateLimitCostFromMetadata && cost.Metadata != nil:
+		return &ir.RateLimitCost{
+			Format: ptr.To(fmt.Sprintf("%%DYNAMIC_METADATA(%s:%s)%%", cost.Metadata.Namespace, cost.Metadata.Key)),
+		}, nil
+	}
+	return nil, fmt.Errorf(
+		"unable to translate rateLimit. Either the cost.From is not valid or the cost is missing a %s value",
+		strings.ToLower(string(cost.From)))
+}
+
+func buildRateLimitQueryParamMatch(queryParam egv1a1.QueryParamMatch) (*ir.StringMatch, error) {
+	switch ptr.Deref(queryParam.Type, egv1a1.QueryParamMatchExact) {
+	case egv1a1.QueryParamMatchExact:
+		if queryParam.Value != nil {
+			return &ir.StringMatch{
+				Name:   queryParam.Name,
+				Exact:  queryParam.Value,
+				Invert: queryParam.Invert,
+			}, nil
+		}
+	case egv1a1.QueryParamMatchRegularExpression:
+		if queryParam.Value != nil {
+			if err := regex.Validate(*queryParam.Value); err != nil {
+				return nil, err
+			}
+			return &ir.StringMatch{
+				Name:      queryParam.Name,
+				SafeRegex: queryParam.Value,
+				Invert:    queryParam.Invert,
+			}, nil
+		}
+	case egv1a1.QueryParamMatchDistinct:
+		if queryParam.Value == nil {
+			if queryParam.Invert != nil && *queryParam.Invert {
+				return nil, fmt.Errorf("unable to translate rateLimit. " +
+					"Invert is not applicable for distinct queryParam match type")
+			}
+			return &ir.StringMatch{
+				Name:     queryParam.Name,
+				Distinct: true,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf(
+		"unable to translate rateLimit. Either the queryParam." +
+			"Type is not valid or the queryParam is missing a value")
+}
+
 func int64ToUint32(in int64) (uint32, bool) {
 	if in >= 0 && in <= math.MaxUint32 {
 		return uint32(in), true
@@ -822,6 +1050,186 @@ func (t *Translator) buildFaultInjection(policy *egv1a1.BackendTrafficPolicy) *i
 	return fi
 }
 
+func buildAdaptiveConcurrency(ac *egv1a1.AdaptiveConcurrency) (*ir.AdaptiveConcurrency, error) {
+	if ac == nil {
+		return nil, nil
+	}
+
+	var err error
+	irAC := &ir.AdaptiveConcurrency{
+		SampleAggregatePercentile: ac.SampleAggregatePercentile,
+		MaxConcurrencyLimit:       ac.MaxConcurrencyLimit,
+	}
+	if irAC.SampleWindow, err = parseOptionalDuration(ac.SampleWindow); err != nil {
+		return nil, fmt.Errorf("invalid sampleWindow: %w", err)
+	}
+
+	if ac.MinRTT != nil {
+		irAC.MinRTT = &ir.AdaptiveConcurrencyMinRTT{
+			RequestCount:   ac.MinRTT.RequestCount,
+			Jitter:         ac.MinRTT.Jitter,
+			MinConcurrency: ac.MinRTT.MinConcurrency,
+			Buffer:         ac.MinRTT.Buffer,
+		}
+		if irAC.MinRTT.Interval, err = parseOptionalDuration(ac.MinRTT.Interval); err != nil {
+			return nil, fmt.Errorf("invalid minRTT interval: %w", err)
+		}
+		if irAC.MinRTT.FixedValue, err = parseOptionalDuration(ac.MinRTT.FixedValue); err != nil {
+			return nil, fmt.Errorf("invalid minRTT fixedValue: %w", err)
+		}
+	}
+
+	return irAC, nil
+}
+
+func buildAdmissionControl(adc *egv1a1.AdmissionControl) (*ir.AdmissionControl, error) {
+	if adc == nil {
+		return nil, nil
+	}
+
+	var err error
+	irADC := &ir.AdmissionControl{
+		SuccessRateThreshold:    adc.SuccessRateThreshold,
+		Aggression:              adc.Aggression,
+		MinRPS:                  adc.MinRPS,
+		MaxRejectionProbability: adc.MaxRejectionProbability,
+	}
+	if irADC.SamplingWindow, err = parseOptionalDuration(adc.SamplingWindow); err != nil {
+		return nil, fmt.Errorf("invalid samplingWindow: %w", err)
+	}
+
+	if adc.SuccessCriteria != nil {
+		if adc.SuccessCriteria.HTTP != nil {
+			for _, r := range adc.SuccessCriteria.HTTP.StatusRanges {
+				irADC.HTTPSuccessStatuses = append(irADC.HTTPSuccessStatuses, ir.StatusCodeRange{
+					Start: r.Start,
+					End:   r.End,
+				})
+			}
+		}
+		if adc.SuccessCriteria.GRPC != nil {
+			irADC.GRPCSuccessStatuses = adc.SuccessCriteria.GRPC.Statuses
+		}
+	}
+
+	return irADC, nil
+}
+
+// buildCompression builds the IR compressors of the provided compression config.
+// The compression settings are applied to all the compressors.
+func buildCompression(compression []*egv1a1.Compression, settings *egv1a1.CompressionSettings) []*ir.Compression {
+	if len(compression) == 0 {
+		return nil
+	}
+
+	irCompression := make([]*ir.Compression, 0, len(compression))
+	for _, c := range compression {
+		irc := &ir.Compression{
+			Type:   c.Type,
+			Gzip:   c.Gzip,
+			Brotli: c.Brotli,
+			Zstd:   c.Zstd,
+		}
+		if settings != nil {
+			irc.MinContentLength = settings.MinContentLength
+			irc.ContentTypes = settings.ContentTypes
+		}
+		irCompression = append(irCompression, irc)
+	}
+
+	return irCompression
+}
+
+func buildCache(cache *egv1a1.Cache) (*ir.Cache, error) {
+	if cache == nil {
+		return nil, nil
+	}
+
+	var err error
+	irCache := &ir.Cache{
+		VaryHeaders: cache.VaryHeaders,
+	}
+	if cache.MaxSize != nil {
+		maxSize, ok := cache.MaxSize.AsInt64()
+		if !ok {
+			return nil, fmt.Errorf("invalid maxSize value %s", cache.MaxSize.String())
+		}
+		if maxSize <= 0 {
+			return nil, fmt.Errorf("maxSize value %s is out of range", cache.MaxSize.String())
+		}
+		irCache.MaxSizeBytes = ptr.To(uint64(maxSize))
+	}
+	if cache.MaxBodySize != nil {
+		maxBodySize, ok := cache.MaxBodySize.AsInt64()
+		if !ok {
+			return nil, fmt.Errorf("invalid maxBodySize value %s", cache.MaxBodySize.String())
+		}
+		if maxBodySize < 0 || maxBodySize > math.MaxUint32 {
+			return nil, fmt.Errorf("maxBodySize value %s is out of range", cache.MaxBodySize.String())
+		}
+		irCache.MaxBodyBytes = ptr.To(uint32(maxBodySize))
+	}
+	for _, m := range cache.Methods {
+		irCache.Methods = append(irCache.Methods, string(m))
+	}
+	if len(cache.StatusCodes) > 0 {
+		irCache.StatusCodes = makeIrStatusSet(cache.StatusCodes)
+	}
+	if irCache.TTL, err = parseOptionalDuration(cache.TTL); err != nil {
+		return nil, fmt.Errorf("invalid ttl: %w", err)
+	}
+
+	return irCache, nil
+}
+
+func buildRequestBuffer(requestBuffer *egv1a1.RequestBuffer) (*ir.RequestBuffer, error) {
+	if requestBuffer == nil {
+		return nil, nil
+	}
+
+	limit, ok := requestBuffer.Limit.AsInt64()
+	if !ok {
+		return nil, fmt.Errorf("invalid limit value %s", requestBuffer.Limit.String())
+	}
+	if limit <= 0 || limit > math.MaxUint32 {
+		return nil, fmt.Errorf("limit value %s is out of range", requestBuffer.Limit.String())
+	}
+
+	return &ir.RequestBuffer{
+		LimitBytes: uint32(limit),
+	}, nil
+}
+
+func buildBandwidthLimit(bandwidthLimit *egv1a1.BandwidthLimit) (*ir.BandwidthLimit, error) {
+	if bandwidthLimit == nil {
+		return nil, nil
+	}
+
+	var err error
+	irBandwidthLimit := &ir.BandwidthLimit{
+		Direction: ptr.Deref(bandwidthLimit.Direction, egv1a1.BandwidthLimitDirectionResponse),
+		LimitKbps: bandwidthLimit.LimitKbps,
+	}
+	if irBandwidthLimit.FillInterval, err = parseOptionalDuration(bandwidthLimit.FillInterval); err != nil {
+		return nil, fmt.Errorf("invalid fillInterval: %w", err)
+	}
+
+	return irBandwidthLimit, nil
+}
+
+// parseOptionalDuration converts the provided Gateway API duration, if any, to
+// a metav1 duration.
+func parseOptionalDuration(d *gwapiv1.Duration) (*metav1.Duration, error) {
+	if d == nil {
+		return nil, nil
+	}
+	parsed, err := time.ParseDuration(string(*d))
+	if err != nil {
+		return nil, err
+	}
+	return ptr.To(metav1.Duration{Duration: parsed}), nil
+}
+
 func makeIrStatusSet(in []egv1a1.HTTPStatus) []ir.HTTPStatus {
 	statusSet := sets.NewInt()
 	for _, r := range in {
@@ -930,3 +1338,79 @@ func defaultResponseOverrideRuleName(policy *egv1a1.BackendTrafficPolicy, index
 		irConfigName(policy),
 		strconv.Itoa(index))
 }
+
+func (t *Translator) buildOAuth2ClientCredentials(
+	policy *egv1a1.BackendTrafficPolicy,
+	resources *resource.Resources,
+	envoyProxy *egv1a1.EnvoyProxy,
+) (*ir.OAuth2ClientCredentials, error) {
+	var (
+		cc           = policy.Spec.OAuth2ClientCredentials
+		clientSecret *corev1.Secret
+		protocol     = ir.HTTP
+		rd           *ir.RouteDestination
+		traffic      *ir.TrafficFeatures
+		err          error
+	)
+
+	if err = validateTokenEndpoint(cc.TokenEndpoint); err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(cc.TokenEndpoint)
+	if err != nil {
+		return nil, err
+	}
+	if u.Scheme == "https" {
+		protocol = ir.HTTPS
+	}
+
+	if len(cc.BackendRefs) > 0 {
+		if rd, err = t.translateExtServiceBackendRefs(policy, cc.BackendRefs, protocol, resources, envoyProxy, "oauth2", 0); err != nil {
+			return nil, err
+		}
+	}
+
+	if traffic, err = translateTrafficFeatures(cc.BackendSettings); err != nil {
+		return nil, err
+	}
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      resource.KindBackendTrafficPolicy,
+		namespace: policy.Namespace,
+	}
+
+	if clientSecret, err = t.validateSecretRef(
+		false, from, cc.ClientSecret, resources); err != nil {
+		return nil, err
+	}
+
+	clientSecretBytes, ok := clientSecret.Data[egv1a1.OIDCClientSecretKey]
+	if !ok || len(clientSecretBytes) == 0 {
+		return nil, fmt.Errorf(
+			"client secret not found in secret %s/%s",
+			clientSecret.Namespace, clientSecret.Name)
+	}
+
+	irCC := &ir.OAuth2ClientCredentials{
+		Name:                 fmt.Sprintf("%s/oauth2", irConfigName(policy)),
+		TokenEndpoint:        cc.TokenEndpoint,
+		Destination:          rd,
+		Traffic:              traffic,
+		ClientID:             cc.ClientID,
+		ClientSecret:         clientSecretBytes,
+		Scopes:               cc.Scopes,
+		AuthenticationMethod: ptr.Deref(cc.AuthenticationMethod, egv1a1.OAuth2ClientSecretBasic),
+	}
+
+	if cc.TokenFetchRetryInterval != nil {
+		d, err := time.ParseDuration(string(*cc.TokenFetchRetryInterval))
+		if err != nil {
+			return nil, fmt.Errorf("invalid tokenFetchRetryInterval: %w", err)
+		}
+		irCC.TokenFetchRetryInterval = ptr.To(metav1.Duration{Duration: d})
+	}
+
+	return irCC, nil
+}
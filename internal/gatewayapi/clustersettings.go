@@ -11,6 +11,7 @@ import (
 	"math"
 	"math/big"
 	"net/http"
+	"reflect"
 	"strings"
 	"time"
 
@@ -75,8 +76,7 @@ func translateTrafficFeatures(policy *egv1a1.ClusterSettings) (*ir.TrafficFeatur
 
 	// If nothing was set in any of the above calls, return nil instead of an empty
 	// container
-	var empty ir.TrafficFeatures
-	if empty == *ret {
+	if reflect.DeepEqual(*ret, ir.TrafficFeatures{}) {
 		ret = nil
 	}
 
@@ -299,9 +299,29 @@ func buildLoadBalancer(policy egv1a1.ClusterSettings) (*ir.LoadBalancer, error)
 		}
 	}
 
+	if lb != nil && policy.LoadBalancer.ZoneAware != nil {
+		lb.ZoneAware = buildZoneAware(policy.LoadBalancer.ZoneAware)
+	}
+
 	return lb, nil
 }
 
+func buildZoneAware(zoneAware *egv1a1.ZoneAware) *ir.ZoneAware {
+	za := &ir.ZoneAware{}
+	if zoneAware.PreferLocal != nil {
+		za.PreferLocal = &ir.PreferLocalZone{
+			MinEndpointsThreshold: zoneAware.PreferLocal.MinEndpointsThreshold,
+		}
+	}
+	for _, wz := range zoneAware.WeightedZones {
+		za.WeightedZones = append(za.WeightedZones, ir.WeightedZone{
+			Zone:   wz.Zone,
+			Weight: wz.Weight,
+		})
+	}
+	return za
+}
+
 func buildConsistentHashLoadBalancer(policy egv1a1.LoadBalancer) (*ir.ConsistentHash, error) {
 	consistentHash := &ir.ConsistentHash{}
 
@@ -529,10 +549,22 @@ func buildRetry(r *egv1a1.Retry) *ir.Retry {
 			}
 		}
 
+		if ptr.Deref(r.PerRetry.HedgeOnTimeout, false) {
+			pr.HedgeOnTimeout = true
+			bpr = true
+		}
+
 		if bpr {
 			rt.PerRetry = pr
 		}
 	}
 
+	if r.Budget != nil {
+		rt.Budget = &ir.RetryBudget{
+			Percent:        r.Budget.Percent,
+			MinConcurrency: r.Budget.MinConcurrency,
+		}
+	}
+
 	return rt
 }
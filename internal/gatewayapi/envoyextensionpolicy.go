@@ -14,6 +14,9 @@ import (
 	"time"
 
 	perr "github.com/pkg/errors"
+	luaparse "github.com/yuin/gopher-lua/parse"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -293,6 +296,8 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 ) error {
 	var (
 		wasms     []ir.Wasm
+		luas      []ir.Lua
+		gjt       *ir.GRPCJSONTranscoder
 		err, errs error
 	)
 
@@ -300,10 +305,24 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 		err = perr.WithMessage(err, "Wasm")
 		errs = errors.Join(errs, err)
 	}
+	if luas, err = buildLuas(policy, resources); err != nil {
+		err = perr.WithMessage(err, "Lua")
+		errs = errors.Join(errs, err)
+	}
+	if gjt, err = buildGRPCJSONTranscoder(policy, resources); err != nil {
+		err = perr.WithMessage(err, "GRPCJSONTranscoder")
+		errs = errors.Join(errs, err)
+	}
 
 	// Apply IR to all relevant routes
 	prefix := irRoutePrefix(route)
 	parentRefs := GetParentReferences(route)
+	if gjt != nil && errs == nil {
+		if err = t.validateGRPCJSONTranscoderRoutes(route, xdsIR); err != nil {
+			err = perr.WithMessage(err, "GRPCJSONTranscoder")
+			errs = errors.Join(errs, err)
+		}
+	}
 	for _, p := range parentRefs {
 		parentRefCtx := GetRouteParentContext(route, p)
 		gtwCtx := parentRefCtx.GetGateway()
@@ -330,8 +349,10 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 							continue
 						}
 						r.EnvoyExtensions = &ir.EnvoyExtensionFeatures{
-							ExtProcs: extProcs,
-							Wasms:    wasms,
+							ExtProcs:           extProcs,
+							Wasms:              wasms,
+							Luas:               luas,
+							GRPCJSONTranscoder: gjt,
 						}
 					}
 				}
@@ -342,6 +363,34 @@ func (t *Translator) translateEnvoyExtensionPolicyForRoute(
 	return errs
 }
 
+// validateGRPCJSONTranscoderRoutes returns an error if a destination of the IR
+// routes built from the provided route doesn't use HTTP/2.
+func (t *Translator) validateGRPCJSONTranscoderRoutes(route RouteContext, xdsIR resource.XdsIRMap) error {
+	prefix := irRoutePrefix(route)
+	for _, p := range GetParentReferences(route) {
+		parentRefCtx := GetRouteParentContext(route, p)
+		gtwCtx := parentRefCtx.GetGateway()
+		if gtwCtx == nil {
+			continue
+		}
+		irKey := t.getIRKey(gtwCtx.Gateway)
+		for _, listener := range parentRefCtx.listeners {
+			irListener := xdsIR[irKey].GetHTTPListener(irListenerName(listener))
+			if irListener == nil {
+				continue
+			}
+			for _, r := range irListener.Routes {
+				if strings.HasPrefix(r.Name, prefix) {
+					if err := validateGRPCJSONTranscoderDestinations(r); err != nil {
+						return err
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
 func (t *Translator) translateEnvoyExtensionPolicyForGateway(
 	policy *egv1a1.EnvoyExtensionPolicy,
 	target gwapiv1a2.LocalPolicyTargetReferenceWithSectionName,
@@ -352,6 +401,8 @@ func (t *Translator) translateEnvoyExtensionPolicyForGateway(
 	var (
 		extProcs  []ir.ExtProc
 		wasms     []ir.Wasm
+		luas      []ir.Lua
+		gjt       *ir.GRPCJSONTranscoder
 		err, errs error
 	)
 
@@ -363,6 +414,14 @@ func (t *Translator) translateEnvoyExtensionPolicyForGateway(
 		err = perr.WithMessage(err, "Wasm")
 		errs = errors.Join(errs, err)
 	}
+	if luas, err = buildLuas(policy, resources); err != nil {
+		err = perr.WithMessage(err, "Lua")
+		errs = errors.Join(errs, err)
+	}
+	if gjt, err = buildGRPCJSONTranscoder(policy, resources); err != nil {
+		err = perr.WithMessage(err, "GRPCJSONTranscoder")
+		errs = errors.Join(errs, err)
+	}
 
 	irKey := t.getIRKey(gateway.Gateway)
 	// Should exist since we've validated this
@@ -395,6 +454,11 @@ func (t *Translator) translateEnvoyExtensionPolicyForGateway(
 			r.EnvoyExtensions = &ir.EnvoyExtensionFeatures{
 				ExtProcs: extProcs,
 				Wasms:    wasms,
+				Luas:     luas,
+			}
+			// The transcoder is only applied to the routes with HTTP/2 backends.
+			if gjt != nil && validateGRPCJSONTranscoderDestinations(r) == nil {
+				r.EnvoyExtensions.GRPCJSONTranscoder = gjt
 			}
 		}
 	}
@@ -706,3 +770,176 @@ func irConfigNameForWasm(policy client.Object, index int) string {
 		irConfigName(policy),
 		strconv.Itoa(index))
 }
+
+func buildLuas(policy *egv1a1.EnvoyExtensionPolicy, resources *resource.Resources) ([]ir.Lua, error) {
+	var luaIRList []ir.Lua
+
+	if policy == nil {
+		return nil, nil
+	}
+
+	for idx, l := range policy.Spec.Lua {
+		name := irConfigNameForLua(policy, idx)
+		code, err := getLuaCode(l, resources, policy.Namespace)
+		if err != nil {
+			return nil, err
+		}
+		if err = validateLuaSyntax(name, code); err != nil {
+			return nil, err
+		}
+		luaIRList = append(luaIRList, ir.Lua{
+			Name:     name,
+			Code:     code,
+			FailOpen: ptr.Deref(l.FailOpen, false),
+		})
+	}
+	return luaIRList, nil
+}
+
+// getLuaCode returns the Lua source code, either inline or from the referenced ConfigMap.
+func getLuaCode(l egv1a1.Lua, resources *resource.Resources, policyNs string) (string, error) {
+	switch l.Type {
+	case egv1a1.LuaValueTypeInline:
+		if l.Inline == nil {
+			return "", fmt.Errorf("missing Inline field in Lua")
+		}
+		return *l.Inline, nil
+	case egv1a1.LuaValueTypeValueRef:
+		if l.ValueRef == nil {
+			return "", fmt.Errorf("missing ValueRef field in Lua")
+		}
+		cm := resources.GetConfigMap(policyNs, string(l.ValueRef.Name))
+		if cm == nil {
+			return "", fmt.Errorf("can't find the referenced configmap %s", l.ValueRef.Name)
+		}
+		code, ok := cm.Data["lua"]
+		if !ok {
+			return "", fmt.Errorf("can't find the key lua in the referenced configmap %s", l.ValueRef.Name)
+		}
+		return code, nil
+	default:
+		return "", fmt.Errorf("unsupported Lua value type %q", l.Type)
+	}
+}
+
+// validateLuaSyntax parses the Lua source code to catch the syntax errors before
+// sending it to Envoy. The code is not executed.
+// The parser implements the Lua 5.1 grammar with the goto statements and the
+// labels, which LuaJIT also supports.
+func validateLuaSyntax(name, code string) error {
+	if _, err := luaparse.Parse(strings.NewReader(code), name); err != nil {
+		return fmt.Errorf("invalid Lua code: %s", strings.TrimSpace(err.Error()))
+	}
+	return nil
+}
+
+func irConfigNameForLua(policy client.Object, index int) string {
+	return fmt.Sprintf(
+		"%s/lua/%s",
+		irConfigName(policy),
+		strconv.Itoa(index))
+}
+
+// validateGRPCJSONTranscoderDestinations returns an error if a destination or a
+// mirror of the route doesn't use HTTP/2. The gRPC-JSON transcoder sends the
+// transcoded requests to the backends over gRPC, which requires HTTP/2.
+func validateGRPCJSONTranscoderDestinations(r *ir.HTTPRoute) error {
+	destinations := []*ir.RouteDestination{r.Destination}
+	for _, mirror := range r.Mirrors {
+		destinations = append(destinations, mirror.Destination)
+	}
+	for _, destination := range destinations {
+		if destination == nil {
+			continue
+		}
+		for _, ds := range destination.Settings {
+			if ds.Protocol != ir.HTTP2 && ds.Protocol != ir.GRPC {
+				return fmt.Errorf("the backends of route %s must use HTTP/2, for example with the "+
+					"kubernetes.io/h2c appProtocol, to receive the transcoded gRPC requests", r.Name)
+			}
+		}
+	}
+	return nil
+}
+
+func buildGRPCJSONTranscoder(policy *egv1a1.EnvoyExtensionPolicy, resources *resource.Resources) (*ir.GRPCJSONTranscoder, error) {
+	if policy == nil || policy.Spec.GRPCJSONTranscoder == nil {
+		return nil, nil
+	}
+
+	gjt := policy.Spec.GRPCJSONTranscoder
+	descriptorBin, err := getProtoDescriptorSet(gjt.ProtoDescriptorSet, resources, policy.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	if err = validateProtoDescriptorSet(descriptorBin, gjt.Services); err != nil {
+		return nil, err
+	}
+
+	return &ir.GRPCJSONTranscoder{
+		Name:                irConfigName(policy),
+		ProtoDescriptorBin:  descriptorBin,
+		Services:            gjt.Services,
+		PrintOptions:        gjt.PrintOptions,
+		RejectUnknownMethod: !ptr.Deref(gjt.PassthroughUnknownPaths, true),
+	}, nil
+}
+
+// getProtoDescriptorSet returns the binary proto descriptor set from the referenced
+// ConfigMap or Secret.
+func getProtoDescriptorSet(ds egv1a1.ProtoDescriptorSet, resources *resource.Resources, policyNs string) ([]byte, error) {
+	var (
+		descriptorBin []byte
+		found         bool
+	)
+
+	switch string(ds.ValueRef.Kind) {
+	case resource.KindConfigMap:
+		cm := resources.GetConfigMap(policyNs, string(ds.ValueRef.Name))
+		if cm == nil {
+			return nil, fmt.Errorf("can't find the referenced configmap %s", ds.ValueRef.Name)
+		}
+		descriptorBin, found = cm.BinaryData[ds.Key]
+	case resource.KindSecret:
+		secret := resources.GetSecret(policyNs, string(ds.ValueRef.Name))
+		if secret == nil {
+			return nil, fmt.Errorf("can't find the referenced secret %s", ds.ValueRef.Name)
+		}
+		descriptorBin, found = secret.Data[ds.Key]
+	default:
+		return nil, fmt.Errorf("unsupported proto descriptor set reference kind %q", ds.ValueRef.Kind)
+	}
+
+	if !found || len(descriptorBin) == 0 {
+		return nil, fmt.Errorf("can't find the binary key %s in the referenced %s %s",
+			ds.Key, strings.ToLower(string(ds.ValueRef.Kind)), ds.ValueRef.Name)
+	}
+	return descriptorBin, nil
+}
+
+// validateProtoDescriptorSet parses the proto descriptor set, and checks that it
+// defines the provided services.
+func validateProtoDescriptorSet(descriptorBin []byte, services []string) error {
+	fds := &descriptorpb.FileDescriptorSet{}
+	if err := proto.Unmarshal(descriptorBin, fds); err != nil {
+		return fmt.Errorf("invalid proto descriptor set: %w", err)
+	}
+
+	definedServices := sets.New[string]()
+	for _, file := range fds.GetFile() {
+		for _, service := range file.GetService() {
+			if file.GetPackage() == "" {
+				definedServices.Insert(service.GetName())
+			} else {
+				definedServices.Insert(file.GetPackage() + "." + service.GetName())
+			}
+		}
+	}
+
+	for _, service := range services {
+		if !definedServices.Has(service) {
+			return fmt.Errorf("service %s is not defined in the proto descriptor set", service)
+		}
+	}
+	return nil
+}
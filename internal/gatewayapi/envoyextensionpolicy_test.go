@@ -64,3 +64,55 @@ func Test_hasTag(t *testing.T) {
 		})
 	}
 }
+
+func Test_validateLuaSyntax(t *testing.T) {
+	tests := []struct {
+		name    string
+		code    string
+		wantErr string
+	}{
+		{
+			name: "valid code",
+			code: `function envoy_on_request(request_handle)
+  request_handle:headers():add("x-lua-request", "true")
+end`,
+		},
+		{
+			name: "goto and label",
+			code: `function envoy_on_request(request_handle)
+  for i = 1, 3 do
+    if i == 2 then
+      goto continue
+    end
+    request_handle:logInfo(tostring(i))
+    :: continue ::
+  end
+end`,
+		},
+		{
+			name:    "syntax error",
+			code:    "function envoy_on_request(request_handle)\n  request_handle:headers():add(\"x-lua-request\", \"true\"\nend",
+			wantErr: "invalid Lua code: lua line:3(column:3) near 'end':   syntax error",
+		},
+		{
+			name:    "goto without a label",
+			code:    "for i = 1, 3 do\n  goto\nend",
+			wantErr: "invalid Lua code: lua line:3(column:3) near 'end':   syntax error",
+		},
+		{
+			name:    "unterminated string",
+			code:    "local s = \"x-lua-request",
+			wantErr: "invalid Lua code:",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := validateLuaSyntax("lua", tt.code)
+			if tt.wantErr == "" {
+				assert.NoError(t, err)
+				return
+			}
+			assert.ErrorContains(t, err, tt.wantErr)
+		})
+	}
+}
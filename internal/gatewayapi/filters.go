@@ -6,6 +6,7 @@
 package gatewayapi
 
 import (
+	"errors"
 	"fmt"
 	"regexp"
 	"strings"
@@ -51,13 +52,15 @@ type HTTPFilterIR struct {
 
 	URLRewrite *ir.URLRewrite
 
+	CredentialInjection *ir.CredentialInjection
+
 	AddRequestHeaders    []ir.AddHeader
 	RemoveRequestHeaders []string
 
 	AddResponseHeaders    []ir.AddHeader
 	RemoveResponseHeaders []string
 
-	Mirrors []*ir.RouteDestination
+	Mirrors []*ir.MirrorPolicy
 
 	ExtensionRefs []*ir.UnstructuredRef
 }
@@ -927,6 +930,15 @@ func (t *Translator) processExtensionRefHTTPFilter(extFilter *gwapiv1.LocalObjec
 
 					filterContext.HTTPFilterIR.DirectResponse = dr
 				}
+
+				if hrf.Spec.CredentialInjection != nil {
+					ci, err := t.buildCredentialInjection(hrf, resources)
+					if err != nil {
+						t.processInvalidHTTPFilter(string(extFilter.Kind), filterContext, err)
+						return
+					}
+					filterContext.HTTPFilterIR.CredentialInjection = ci
+				}
 			}
 		}
 		if !found {
@@ -967,6 +979,42 @@ func (t *Translator) processExtensionRefHTTPFilter(extFilter *gwapiv1.LocalObjec
 	t.processUnresolvedHTTPFilter(errMsg, filterContext)
 }
 
+// buildCredentialInjection translates the credential injection configuration of
+// an HTTPRouteFilter to the IR, reading the credential from the referenced Secret.
+func (t *Translator) buildCredentialInjection(
+	hrf *egv1a1.HTTPRouteFilter,
+	resources *resource.Resources,
+) (*ir.CredentialInjection, error) {
+	credentialInjection := hrf.Spec.CredentialInjection
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      egv1a1.KindHTTPRouteFilter,
+		namespace: hrf.Namespace,
+	}
+	secret, err := t.validateSecretRef(false, from, credentialInjection.Credential.ValueRef, resources)
+	if err != nil {
+		return nil, err
+	}
+
+	credential, ok := secret.Data[egv1a1.InjectedCredentialKey]
+	if !ok || len(credential) == 0 {
+		return nil, fmt.Errorf("credential not found in secret %s/%s", secret.Namespace, secret.Name)
+	}
+
+	header := "Authorization"
+	if credentialInjection.Header != nil {
+		header = *credentialInjection.Header
+	}
+
+	return &ir.CredentialInjection{
+		Name:       fmt.Sprintf("%s/%s/%s", strings.ToLower(egv1a1.KindHTTPRouteFilter), hrf.Namespace, hrf.Name),
+		Header:     header,
+		Overwrite:  ptr.Deref(credentialInjection.Overwrite, false),
+		Credential: credential,
+	}, nil
+}
+
 func (t *Translator) processRequestMirrorFilter(
 	filterIdx int,
 	mirrorFilter *gwapiv1.HTTPRequestMirrorFilter,
@@ -978,39 +1026,65 @@ func (t *Translator) processRequestMirrorFilter(
 		return nil
 	}
 
-	mirrorBackend := mirrorFilter.BackendRef
+	percentage, err := mirrorPercentage(mirrorFilter)
+	if err != nil {
+		t.processInvalidHTTPFilter(string(gwapiv1.HTTPRouteFilterRequestMirror), filterContext, err)
+		return nil
+	}
 
-	// Wrap the filter's BackendObjectReference into a BackendRef so we can use existing tooling to check it
+	// Wrap the filter's BackendObjectReference into a BackendRef of the route type so we can
+	// use existing tooling to check it, including the ReferenceGrant checks for the route kind.
 	weight := int32(1)
-	mirrorBackendRef := gwapiv1.HTTPBackendRef{
-		BackendRef: gwapiv1.BackendRef{
-			BackendObjectReference: mirrorBackend,
-			Weight:                 &weight,
-		},
+	backendRef := gwapiv1.BackendRef{
+		BackendObjectReference: mirrorFilter.BackendRef,
+		Weight:                 &weight,
 	}
-
-	// This sets the status on the HTTPRoute, should the usage be changed so that the status message reflects that the backendRef is from the filter?
-	filterNs := filterContext.Route.GetNamespace()
-	serviceNamespace := NamespaceDerefOr(mirrorBackend.Namespace, filterNs)
-	err := t.validateBackendRef(mirrorBackendRef, filterContext.ParentRef, filterContext.Route,
-		resources, serviceNamespace, resource.KindHTTPRoute)
-	if err != nil {
-		return err
+	var mirrorBackendRef BackendRefContext
+	if GetRouteType(filterContext.Route) == resource.KindGRPCRoute {
+		mirrorBackendRef = gwapiv1.GRPCBackendRef{BackendRef: backendRef}
+	} else {
+		mirrorBackendRef = gwapiv1.HTTPBackendRef{BackendRef: backendRef}
 	}
 
+	// This sets the status on the route, should the usage be changed so that the status message reflects that the backendRef is from the filter?
 	ds, err := t.processDestination(mirrorBackendRef, filterContext.ParentRef, filterContext.Route, resources)
 	if err != nil {
 		return err
 	}
 
-	newMirror := &ir.RouteDestination{
-		Name:     fmt.Sprintf("%s-mirror-%d", irRouteDestinationName(filterContext.Route, filterContext.RuleIdx), filterIdx),
-		Settings: []*ir.DestinationSetting{ds},
+	newMirror := &ir.MirrorPolicy{
+		Destination: &ir.RouteDestination{
+			Name:     fmt.Sprintf("%s-mirror-%d", irRouteDestinationName(filterContext.Route, filterContext.RuleIdx), filterIdx),
+			Settings: []*ir.DestinationSetting{ds},
+		},
+		Percentage: percentage,
 	}
 	filterContext.Mirrors = append(filterContext.Mirrors, newMirror)
 	return nil
 }
 
+// mirrorPercentage returns the percentage of the requests to mirror from the percent
+// or fraction of the mirror filter, or nil if all the requests are mirrored.
+func mirrorPercentage(mirrorFilter *gwapiv1.HTTPRequestMirrorFilter) (*float32, error) {
+	switch {
+	case mirrorFilter.Percent != nil && mirrorFilter.Fraction != nil:
+		return nil, errors.New("only one of percent or fraction can be specified")
+	case mirrorFilter.Percent != nil:
+		if *mirrorFilter.Percent < 0 || *mirrorFilter.Percent > 100 {
+			return nil, fmt.Errorf("percent %d must be between 0 and 100", *mirrorFilter.Percent)
+		}
+		return ptr.To(float32(*mirrorFilter.Percent)), nil
+	case mirrorFilter.Fraction != nil:
+		numerator := mirrorFilter.Fraction.Numerator
+		denominator := ptr.Deref(mirrorFilter.Fraction.Denominator, 100)
+		if numerator < 0 || denominator <= 0 || numerator > denominator {
+			return nil, fmt.Errorf("fraction %d/%d must be between 0 and 1", numerator, denominator)
+		}
+		return ptr.To(float32(numerator) / float32(denominator) * 100), nil
+	}
+	return nil, nil
+}
+
 func (t *Translator) processUnresolvedHTTPFilter(errMsg string, filterContext *HTTPFiltersContext) {
 	routeStatus := GetRouteStatus(filterContext.Route)
 	status.SetRouteStatusCondition(routeStatus,
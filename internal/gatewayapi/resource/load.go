@@ -228,6 +228,42 @@ func loadKubernetesYAMLToResources(input []byte, addMissingResources bool) (*Res
 				service.Spec.ClusterIP = dummyClusterIP
 			}
 			resources.Services = append(resources.Services, service)
+		case KindConfigMap:
+			configMap := &corev1.ConfigMap{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       KindConfigMap,
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Data:       kobj.(*corev1.ConfigMap).Data,
+				BinaryData: kobj.(*corev1.ConfigMap).BinaryData,
+			}
+			resources.ConfigMaps = append(resources.ConfigMaps, configMap)
+		case KindSecret:
+			typedSecret := kobj.(*corev1.Secret)
+			secret := &corev1.Secret{
+				TypeMeta: metav1.TypeMeta{
+					Kind:       KindSecret,
+					APIVersion: "v1",
+				},
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: namespace,
+				},
+				Type: typedSecret.Type,
+				Data: typedSecret.Data,
+			}
+			// Merge stringData into data, the same way the API server does.
+			for key, value := range typedSecret.StringData {
+				if secret.Data == nil {
+					secret.Data = make(map[string][]byte, len(typedSecret.StringData))
+				}
+				secret.Data[key] = []byte(value)
+			}
+			resources.Secrets = append(resources.Secrets, secret)
 		case KindEnvoyPatchPolicy:
 			typedSpec := spec.Interface()
 			envoyPatchPolicy := &egv1a1.EnvoyPatchPolicy{
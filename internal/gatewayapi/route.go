@@ -436,6 +436,9 @@ func applyHTTPFiltersContextToIRRoute(httpFiltersContext *HTTPFiltersContext, ir
 	if httpFiltersContext.URLRewrite != nil {
 		irRoute.URLRewrite = httpFiltersContext.URLRewrite
 	}
+	if httpFiltersContext.CredentialInjection != nil {
+		irRoute.CredentialInjection = httpFiltersContext.CredentialInjection
+	}
 	if len(httpFiltersContext.AddRequestHeaders) > 0 {
 		irRoute.AddRequestHeaders = httpFiltersContext.AddRequestHeaders
 	}
@@ -739,6 +742,7 @@ func (t *Translator) processHTTPRouteParentRefListener(route RouteContext, route
 					Redirect:              routeRoute.Redirect,
 					DirectResponse:        routeRoute.DirectResponse,
 					URLRewrite:            routeRoute.URLRewrite,
+					CredentialInjection:   routeRoute.CredentialInjection,
 					Mirrors:               routeRoute.Mirrors,
 					ExtensionRefs:         routeRoute.ExtensionRefs,
 					IsHTTP2:               routeRoute.IsHTTP2,
@@ -1627,6 +1631,7 @@ func getIREndpointsFromEndpointSlice(endpointSlice *discoveryv1.EndpointSlice, p
 					ep := ir.NewDestEndpoint(
 						address,
 						uint32(*endpointPort.Port))
+					ep.Zone = endpoint.Zone
 					endpoints = append(endpoints, ep)
 				}
 			}
@@ -16,6 +16,7 @@ import (
 	"sort"
 	"strconv"
 	"strings"
+	"time"
 
 	perr "github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
@@ -31,6 +32,8 @@ import (
 	"github.com/envoyproxy/gateway/internal/gatewayapi/status"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/utils"
+	netutils "github.com/envoyproxy/gateway/internal/utils/net"
+	"github.com/envoyproxy/gateway/internal/utils/regex"
 )
 
 const (
@@ -43,6 +46,15 @@ const (
 	// nolint: gosec
 	oidcHMACSecretName = "envoy-oidc-hmac"
 	oidcHMACSecretKey  = "hmac-secret"
+
+	localJWKSKey = "jwks"
+
+	defaultHMACMaxRequestBytes = 1024 * 1024
+	defaultHMACMaxSkew         = 5 * time.Minute
+	defaultHMACSeparator       = "."
+
+	defaultExtAuthDecisionCacheTTL        = 60 * time.Second
+	defaultExtAuthDecisionCacheMaxEntries = 1000
 )
 
 func (t *Translator) ProcessSecurityPolicies(securityPolicies []*egv1a1.SecurityPolicy,
@@ -329,8 +341,11 @@ func (t *Translator) translateSecurityPolicyForRoute(
 	// Build IR
 	var (
 		cors          *ir.CORS
+		csrf          *ir.CSRF
 		jwt           *ir.JWT
 		basicAuth     *ir.BasicAuth
+		apiKeyAuth    *ir.APIKeyAuth
+		hmacAuth      *ir.HMACAuth
 		authorization *ir.Authorization
 		err, errs     error
 	)
@@ -339,8 +354,20 @@ func (t *Translator) translateSecurityPolicyForRoute(
 		cors = t.buildCORS(policy.Spec.CORS)
 	}
 
+	if policy.Spec.CSRF != nil {
+		if csrf, err = t.buildCSRF(policy.Spec.CSRF); err != nil {
+			err = perr.WithMessage(err, "CSRF")
+			errs = errors.Join(errs, err)
+		}
+	}
+
 	if policy.Spec.JWT != nil {
-		jwt = t.buildJWT(policy.Spec.JWT)
+		if jwt, err = t.buildJWT(
+			policy,
+			resources); err != nil {
+			err = perr.WithMessage(err, "JWT")
+			errs = errors.Join(errs, err)
+		}
 	}
 
 	if policy.Spec.BasicAuth != nil {
@@ -352,8 +379,26 @@ func (t *Translator) translateSecurityPolicyForRoute(
 		}
 	}
 
+	if policy.Spec.APIKeyAuth != nil {
+		if apiKeyAuth, err = t.buildAPIKeyAuth(
+			policy,
+			resources); err != nil {
+			err = perr.WithMessage(err, "APIKeyAuth")
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	if policy.Spec.HMACAuth != nil {
+		if hmacAuth, err = t.buildHMACAuth(
+			policy,
+			resources); err != nil {
+			err = perr.WithMessage(err, "HMACAuth")
+			errs = errors.Join(errs, err)
+		}
+	}
+
 	if policy.Spec.Authorization != nil {
-		if authorization, err = t.buildAuthorization(policy); err != nil {
+		if authorization, err = t.buildAuthorization(policy, resources); err != nil {
 			errs = errors.Join(errs, err)
 		}
 	}
@@ -398,9 +443,12 @@ func (t *Translator) translateSecurityPolicyForRoute(
 					if strings.HasPrefix(r.Name, prefix) {
 						r.Security = &ir.SecurityFeatures{
 							CORS:          cors,
+							CSRF:          csrf,
 							JWT:           jwt,
 							OIDC:          oidc,
 							BasicAuth:     basicAuth,
+							APIKeyAuth:    apiKeyAuth,
+							HMACAuth:      hmacAuth,
 							ExtAuth:       extAuth,
 							Authorization: authorization,
 						}
@@ -428,9 +476,12 @@ func (t *Translator) translateSecurityPolicyForGateway(
 	// Build IR
 	var (
 		cors          *ir.CORS
+		csrf          *ir.CSRF
 		jwt           *ir.JWT
 		oidc          *ir.OIDC
 		basicAuth     *ir.BasicAuth
+		apiKeyAuth    *ir.APIKeyAuth
+		hmacAuth      *ir.HMACAuth
 		extAuth       *ir.ExtAuth
 		authorization *ir.Authorization
 		err, errs     error
@@ -440,8 +491,20 @@ func (t *Translator) translateSecurityPolicyForGateway(
 		cors = t.buildCORS(policy.Spec.CORS)
 	}
 
+	if policy.Spec.CSRF != nil {
+		if csrf, err = t.buildCSRF(policy.Spec.CSRF); err != nil {
+			err = perr.WithMessage(err, "CSRF")
+			errs = errors.Join(errs, err)
+		}
+	}
+
 	if policy.Spec.JWT != nil {
-		jwt = t.buildJWT(policy.Spec.JWT)
+		if jwt, err = t.buildJWT(
+			policy,
+			resources); err != nil {
+			err = perr.WithMessage(err, "JWT")
+			errs = errors.Join(errs, err)
+		}
 	}
 
 	if policy.Spec.OIDC != nil {
@@ -463,6 +526,24 @@ func (t *Translator) translateSecurityPolicyForGateway(
 		}
 	}
 
+	if policy.Spec.APIKeyAuth != nil {
+		if apiKeyAuth, err = t.buildAPIKeyAuth(
+			policy,
+			resources); err != nil {
+			err = perr.WithMessage(err, "APIKeyAuth")
+			errs = errors.Join(errs, err)
+		}
+	}
+
+	if policy.Spec.HMACAuth != nil {
+		if hmacAuth, err = t.buildHMACAuth(
+			policy,
+			resources); err != nil {
+			err = perr.WithMessage(err, "HMACAuth")
+			errs = errors.Join(errs, err)
+		}
+	}
+
 	if policy.Spec.ExtAuth != nil {
 		if extAuth, err = t.buildExtAuth(
 			policy,
@@ -474,7 +555,7 @@ func (t *Translator) translateSecurityPolicyForGateway(
 	}
 
 	if policy.Spec.Authorization != nil {
-		if authorization, err = t.buildAuthorization(policy); err != nil {
+		if authorization, err = t.buildAuthorization(policy, resources); err != nil {
 			errs = errors.Join(errs, err)
 		}
 	}
@@ -505,9 +586,12 @@ func (t *Translator) translateSecurityPolicyForGateway(
 			}
 			r.Security = &ir.SecurityFeatures{
 				CORS:          cors,
+				CSRF:          csrf,
 				JWT:           jwt,
 				OIDC:          oidc,
 				BasicAuth:     basicAuth,
+				APIKeyAuth:    apiKeyAuth,
+				HMACAuth:      hmacAuth,
 				ExtAuth:       extAuth,
 				Authorization: authorization,
 			}
@@ -548,6 +632,22 @@ func (t *Translator) buildCORS(cors *egv1a1.CORS) *ir.CORS {
 	}
 }
 
+func (t *Translator) buildCSRF(csrf *egv1a1.CSRF) (*ir.CSRF, error) {
+	irCSRF := &ir.CSRF{
+		Shadow: ptr.Deref(csrf.Shadow, false),
+	}
+
+	for i := range csrf.AdditionalOrigins {
+		origin, err := irStringMatch("", csrf.AdditionalOrigins[i])
+		if err != nil {
+			return nil, err
+		}
+		irCSRF.AdditionalOrigins = append(irCSRF.AdditionalOrigins, origin)
+	}
+
+	return irCSRF, nil
+}
+
 func isWildcard(s string) bool {
 	return strings.ContainsAny(s, "*")
 }
@@ -558,11 +658,93 @@ func wildcard2regex(wildcard string) string {
 	return regexStr
 }
 
-func (t *Translator) buildJWT(jwt *egv1a1.JWT) *ir.JWT {
+func (t *Translator) buildJWT(
+	policy *egv1a1.SecurityPolicy,
+	resources *resource.Resources,
+) (*ir.JWT, error) {
+	jwt := policy.Spec.JWT
+	providers := make([]egv1a1.JWTProvider, 0, len(jwt.Providers))
+
+	for i := range jwt.Providers {
+		provider := *jwt.Providers[i].DeepCopy()
+
+		// Resolve the referenced JWKS so that the IR only contains inline JWKS.
+		if provider.LocalJWKS != nil {
+			jwks, err := t.getLocalJWKS(policy, provider.LocalJWKS, resources)
+			if err != nil {
+				return nil, err
+			}
+			provider.LocalJWKS = &egv1a1.LocalJWKS{
+				Type:   ptr.To(egv1a1.LocalJWKSTypeInline),
+				Inline: &jwks,
+			}
+		}
+
+		providers = append(providers, provider)
+	}
+
 	return &ir.JWT{
 		AllowMissing: ptr.Deref(jwt.Optional, false),
-		Providers:    jwt.Providers,
+		Providers:    providers,
+	}, nil
+}
+
+// getLocalJWKS returns the JWKS of a local JWKS source, reading it from the
+// referenced ConfigMap or Secret if the source is of type ValueRef.
+func (t *Translator) getLocalJWKS(
+	policy *egv1a1.SecurityPolicy,
+	localJWKS *egv1a1.LocalJWKS,
+	resources *resource.Resources,
+) (string, error) {
+	if localJWKS.Type == nil || *localJWKS.Type == egv1a1.LocalJWKSTypeInline {
+		if localJWKS.Inline == nil || len(*localJWKS.Inline) == 0 {
+			return "", errors.New("inline JWKS must be specified")
+		}
+		return *localJWKS.Inline, nil
+	}
+
+	if localJWKS.ValueRef == nil {
+		return "", errors.New("valueRef must be specified for JWKS of type ValueRef")
+	}
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      resource.KindSecurityPolicy,
+		namespace: policy.Namespace,
+	}
+	ref := gwapiv1.SecretObjectReference{
+		Group: &localJWKS.ValueRef.Group,
+		Kind:  &localJWKS.ValueRef.Kind,
+		Name:  localJWKS.ValueRef.Name,
+	}
+
+	var data map[string]string
+	switch string(localJWKS.ValueRef.Kind) {
+	case resource.KindConfigMap:
+		configMap, err := t.validateConfigMapRef(false, from, ref, resources)
+		if err != nil {
+			return "", err
+		}
+		data = configMap.Data
+	case resource.KindSecret:
+		secret, err := t.validateSecretRef(false, from, ref, resources)
+		if err != nil {
+			return "", err
+		}
+		data = make(map[string]string, len(secret.Data))
+		for key, value := range secret.Data {
+			data[key] = string(value)
+		}
+	default:
+		return "", fmt.Errorf("unsupported JWKS valueRef kind %s", localJWKS.ValueRef.Kind)
+	}
+
+	jwks, ok := data[localJWKSKey]
+	if !ok {
+		return "", fmt.Errorf("can't find the key %s in the referenced %s %s/%s",
+			localJWKSKey, localJWKS.ValueRef.Kind, policy.Namespace, localJWKS.ValueRef.Name)
 	}
+	return jwks, nil
 }
 
 func (t *Translator) buildOIDC(
@@ -837,6 +1019,121 @@ func (t *Translator) buildBasicAuth(
 	}, nil
 }
 
+func (t *Translator) buildAPIKeyAuth(
+	policy *egv1a1.SecurityPolicy,
+	resources *resource.Resources,
+) (*ir.APIKeyAuth, error) {
+	var (
+		apiKeyAuth  = policy.Spec.APIKeyAuth
+		credentials = make(map[string]ir.PrivateBytes)
+	)
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      resource.KindSecurityPolicy,
+		namespace: policy.Namespace,
+	}
+	for _, ref := range apiKeyAuth.CredentialRefs {
+		credentialsSecret, err := t.validateSecretRef(
+			false, from, ref, resources)
+		if err != nil {
+			return nil, err
+		}
+
+		// Sort the client ids so that the generated config is deterministic.
+		clientIDs := make([]string, 0, len(credentialsSecret.Data))
+		for clientID := range credentialsSecret.Data {
+			clientIDs = append(clientIDs, clientID)
+		}
+		sort.Strings(clientIDs)
+
+		for _, clientID := range clientIDs {
+			// If a client id is defined in multiple secrets, the first one is used.
+			if _, ok := credentials[clientID]; ok {
+				continue
+			}
+			key := credentialsSecret.Data[clientID]
+			if len(key) == 0 {
+				return nil, fmt.Errorf(
+					"empty API key for client %s in secret %s/%s",
+					clientID, credentialsSecret.Namespace, credentialsSecret.Name)
+			}
+			credentials[clientID] = key
+		}
+	}
+
+	extractFrom := make([]*ir.ExtractFrom, 0, len(apiKeyAuth.ExtractFrom))
+	for _, e := range apiKeyAuth.ExtractFrom {
+		extractFrom = append(extractFrom, &ir.ExtractFrom{
+			Headers: e.Headers,
+			Params:  e.Params,
+			Cookies: e.Cookies,
+		})
+	}
+
+	return &ir.APIKeyAuth{
+		Name:                  irConfigName(policy),
+		Credentials:           credentials,
+		ExtractFrom:           extractFrom,
+		ForwardClientIDHeader: apiKeyAuth.ForwardClientIDHeader,
+	}, nil
+}
+
+func (t *Translator) buildHMACAuth(
+	policy *egv1a1.SecurityPolicy,
+	resources *resource.Resources,
+) (*ir.HMACAuth, error) {
+	var (
+		hmacAuth = policy.Spec.HMACAuth
+		secret   *corev1.Secret
+		err      error
+	)
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      resource.KindSecurityPolicy,
+		namespace: policy.Namespace,
+	}
+	if secret, err = t.validateSecretRef(
+		false, from, hmacAuth.SecretRef, resources); err != nil {
+		return nil, err
+	}
+
+	secretBytes, ok := secret.Data[egv1a1.HMACAuthSecretKey]
+	if !ok || len(secretBytes) == 0 {
+		return nil, fmt.Errorf(
+			"hmac secret not found in secret %s/%s",
+			secret.Namespace, secret.Name)
+	}
+
+	irHMACAuth := &ir.HMACAuth{
+		Name:            irConfigName(policy),
+		Secret:          secretBytes,
+		SignatureHeader: hmacAuth.SignatureHeader,
+		SignaturePrefix: ptr.Deref(hmacAuth.SignaturePrefix, ""),
+		Algorithm:       ptr.Deref(hmacAuth.Algorithm, egv1a1.HMACAlgorithmSHA256),
+		Encoding:        ptr.Deref(hmacAuth.Encoding, egv1a1.HMACSignatureEncodingHex),
+		MaxRequestBytes: ptr.Deref(hmacAuth.MaxRequestBytes, defaultHMACMaxRequestBytes),
+	}
+
+	if hmacAuth.Timestamp != nil {
+		maxSkew := defaultHMACMaxSkew
+		if hmacAuth.Timestamp.MaxSkew != nil {
+			if maxSkew, err = time.ParseDuration(string(*hmacAuth.Timestamp.MaxSkew)); err != nil {
+				return nil, fmt.Errorf("invalid timestamp maxSkew value %s", *hmacAuth.Timestamp.MaxSkew)
+			}
+		}
+		irHMACAuth.Timestamp = &ir.HMACTimestamp{
+			Header:        hmacAuth.Timestamp.Header,
+			MaxSkew:       metav1.Duration{Duration: maxSkew},
+			Separator:     ptr.Deref(hmacAuth.Timestamp.Separator, defaultHMACSeparator),
+			PayloadPrefix: ptr.Deref(hmacAuth.Timestamp.PayloadPrefix, ""),
+		}
+	}
+
+	return irHMACAuth, nil
+}
+
 func (t *Translator) buildExtAuth(
 	policy *egv1a1.SecurityPolicy,
 	resources *resource.Resources,
@@ -940,6 +1237,27 @@ func (t *Translator) buildExtAuth(
 		}
 	}
 
+	for _, ce := range policy.Spec.ExtAuth.ContextExtensions {
+		extAuth.ContextExtensions = append(extAuth.ContextExtensions, &ir.ExtAuthContextExtension{
+			Name:  ce.Name,
+			Value: ce.Value,
+		})
+	}
+
+	if dc := policy.Spec.ExtAuth.DecisionCache; dc != nil {
+		ttl := defaultExtAuthDecisionCacheTTL
+		if dc.TTL != nil {
+			if ttl, err = time.ParseDuration(string(*dc.TTL)); err != nil {
+				return nil, fmt.Errorf("invalid decision cache TTL %s: %w", *dc.TTL, err)
+			}
+		}
+		extAuth.DecisionCache = &ir.ExtAuthDecisionCache{
+			Headers:    dc.Headers,
+			TTL:        metav1.Duration{Duration: ttl},
+			MaxEntries: ptr.Deref(dc.MaxEntries, defaultExtAuthDecisionCacheMaxEntries),
+		}
+	}
+
 	return extAuth, nil
 }
 
@@ -968,7 +1286,10 @@ func backendRefAuthority(resources *resource.Resources, backendRef *gwapiv1.Back
 	)
 }
 
-func (t *Translator) buildAuthorization(policy *egv1a1.SecurityPolicy) (*ir.Authorization, error) {
+func (t *Translator) buildAuthorization(
+	policy *egv1a1.SecurityPolicy,
+	resources *resource.Resources,
+) (*ir.Authorization, error) {
 	var (
 		authorization = policy.Spec.Authorization
 		irAuth        = &ir.Authorization{}
@@ -984,16 +1305,49 @@ func (t *Translator) buildAuthorization(policy *egv1a1.SecurityPolicy) (*ir.Auth
 	for i, rule := range authorization.Rules {
 		principal := ir.Principal{}
 
-		for _, cidr := range rule.Principal.ClientCIDRs {
-			cidrMatch, err := parseCIDR(string(cidr))
+		if rule.Principal != nil {
+			clientCIDRs, err := t.getClientCIDRs(policy, rule.Principal, resources)
 			if err != nil {
 				return nil, fmt.Errorf("unable to translate authorization rule: %w", err)
 			}
 
-			principal.ClientCIDRs = append(principal.ClientCIDRs, cidrMatch)
+			// The CIDR lists loaded from the sources can be very large, so the
+			// duplicated CIDR ranges are removed to keep the IR small.
+			seen := sets.New[string]()
+			if len(clientCIDRs) > 0 {
+				principal.ClientCIDRs = make([]*ir.CIDRMatch, 0, len(clientCIDRs))
+			}
+			for _, cidr := range clientCIDRs {
+				cidrMatch, err := parseCIDR(cidr)
+				if err != nil {
+					return nil, fmt.Errorf("unable to translate authorization rule: %w", err)
+				}
+				if seen.Has(cidrMatch.CIDR) {
+					continue
+				}
+				seen.Insert(cidrMatch.CIDR)
+
+				principal.ClientCIDRs = append(principal.ClientCIDRs, cidrMatch)
+			}
+
+			principal.JWT = rule.Principal.JWT
+
+			if rule.Principal.ClientCertificate != nil {
+				clientCert, err := buildClientCertificatePrincipal(rule.Principal.ClientCertificate)
+				if err != nil {
+					return nil, fmt.Errorf("unable to translate authorization rule: %w", err)
+				}
+				principal.ClientCertificate = clientCert
+			}
 		}
 
-		principal.JWT = rule.Principal.JWT
+		var operation *ir.Operation
+		if rule.Operation != nil {
+			var err error
+			if operation, err = buildAuthorizationOperation(rule.Operation); err != nil {
+				return nil, fmt.Errorf("unable to translate authorization rule: %w", err)
+			}
+		}
 
 		var name string
 		if rule.Name != nil && *rule.Name != "" {
@@ -1004,6 +1358,7 @@ func (t *Translator) buildAuthorization(policy *egv1a1.SecurityPolicy) (*ir.Auth
 		irAuth.Rules = append(irAuth.Rules, &ir.AuthorizationRule{
 			Name:      name,
 			Action:    rule.Action,
+			Operation: operation,
 			Principal: principal,
 		})
 	}
@@ -1011,6 +1366,157 @@ func (t *Translator) buildAuthorization(policy *egv1a1.SecurityPolicy) (*ir.Auth
 	return irAuth, nil
 }
 
+// getClientCIDRs returns the client CIDR ranges of a principal, including the ones
+// loaded from the CIDR list sources.
+func (t *Translator) getClientCIDRs(
+	policy *egv1a1.SecurityPolicy,
+	principal *egv1a1.Principal,
+	resources *resource.Resources,
+) ([]string, error) {
+	cidrs := make([]string, 0, len(principal.ClientCIDRs))
+	for _, cidr := range principal.ClientCIDRs {
+		cidrs = append(cidrs, string(cidr))
+	}
+
+	from := crossNamespaceFrom{
+		group:     egv1a1.GroupName,
+		kind:      resource.KindSecurityPolicy,
+		namespace: policy.Namespace,
+	}
+
+	for _, source := range principal.ClientCIDRsFrom {
+		switch source.Type {
+		case egv1a1.CIDRListSourceTypeConfigMap:
+			if source.ConfigMap == nil {
+				return nil, errors.New("configMap must be specified for CIDR list source of type ConfigMap")
+			}
+			configMap, err := t.validateConfigMapRef(false, from, gwapiv1.SecretObjectReference{
+				Kind: ptr.To(gwapiv1.Kind(resource.KindConfigMap)),
+				Name: source.ConfigMap.Name,
+			}, resources)
+			if err != nil {
+				return nil, err
+			}
+			data, ok := configMap.Data[source.ConfigMap.Key]
+			if !ok {
+				return nil, fmt.Errorf("can't find the key %s in the referenced configmap %s/%s",
+					source.ConfigMap.Key, configMap.Namespace, configMap.Name)
+			}
+			cidrs = append(cidrs, netutils.SplitCIDRList(data)...)
+		case egv1a1.CIDRListSourceTypeFile:
+			// The file provider inlines the content of the files when loading the
+			// policies, so a remaining File source means that the file can't be loaded.
+			if source.File == nil {
+				return nil, errors.New("file must be specified for CIDR list source of type File")
+			}
+			return nil, fmt.Errorf("unable to load the CIDR list file %s, "+
+				"File sources are only supported by the file provider", source.File.Path)
+		default:
+			return nil, fmt.Errorf("unsupported CIDR list source type %s", source.Type)
+		}
+	}
+
+	return cidrs, nil
+}
+
+func buildAuthorizationOperation(operation *egv1a1.Operation) (*ir.Operation, error) {
+	irOperation := &ir.Operation{}
+
+	for _, method := range operation.Methods {
+		irOperation.Methods = append(irOperation.Methods, string(method))
+	}
+
+	for i := range operation.Paths {
+		match, err := irStringMatch("", operation.Paths[i])
+		if err != nil {
+			return nil, err
+		}
+		irOperation.Paths = append(irOperation.Paths, match)
+	}
+
+	for i := range operation.Headers {
+		header := operation.Headers[i]
+		match, err := irStringMatch(header.Name, header.StringMatch)
+		if err != nil {
+			return nil, err
+		}
+		irOperation.Headers = append(irOperation.Headers, match)
+	}
+
+	for i := range operation.Hosts {
+		match, err := irStringMatch("", operation.Hosts[i])
+		if err != nil {
+			return nil, err
+		}
+		irOperation.Hosts = append(irOperation.Hosts, match)
+	}
+
+	for i := range operation.ServerNames {
+		match, err := irStringMatch("", operation.ServerNames[i])
+		if err != nil {
+			return nil, err
+		}
+		irOperation.ServerNames = append(irOperation.ServerNames, match)
+	}
+
+	return irOperation, nil
+}
+
+func buildClientCertificatePrincipal(clientCert *egv1a1.ClientCertificatePrincipal) (*ir.ClientCertificatePrincipal, error) {
+	irClientCert := &ir.ClientCertificatePrincipal{}
+
+	for i := range clientCert.URISANs {
+		match, err := irStringMatch("", clientCert.URISANs[i])
+		if err != nil {
+			return nil, err
+		}
+		irClientCert.URISANs = append(irClientCert.URISANs, match)
+	}
+
+	for i := range clientCert.DNSSANs {
+		match, err := irStringMatch("", clientCert.DNSSANs[i])
+		if err != nil {
+			return nil, err
+		}
+		irClientCert.DNSSANs = append(irClientCert.DNSSANs, match)
+	}
+
+	for i := range clientCert.Subjects {
+		match, err := irStringMatch("", clientCert.Subjects[i])
+		if err != nil {
+			return nil, err
+		}
+		irClientCert.Subjects = append(irClientCert.Subjects, match)
+	}
+
+	return irClientCert, nil
+}
+
+// irStringMatch converts a StringMatch into an IR StringMatch with the given name.
+func irStringMatch(name string, match egv1a1.StringMatch) (*ir.StringMatch, error) {
+	irMatch := &ir.StringMatch{
+		Name: name,
+	}
+
+	switch ptr.Deref(match.Type, egv1a1.StringMatchExact) {
+	case egv1a1.StringMatchExact:
+		irMatch.Exact = ptr.To(match.Value)
+	case egv1a1.StringMatchPrefix:
+		irMatch.Prefix = ptr.To(match.Value)
+	case egv1a1.StringMatchSuffix:
+		irMatch.Suffix = ptr.To(match.Value)
+	case egv1a1.StringMatchRegularExpression:
+		if err := regex.Validate(match.Value); err != nil {
+			return nil, err
+		}
+		irMatch.SafeRegex = ptr.To(match.Value)
+	default:
+		return nil, fmt.Errorf("unsupported string match type %s", *match.Type)
+	}
+
+	return irMatch, nil
+}
+
 func defaultAuthorizationRuleName(policy *egv1a1.SecurityPolicy, index int) string {
 	return fmt.Sprintf(
 		"%s/authorization/rule/%s",
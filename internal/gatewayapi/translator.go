@@ -9,7 +9,9 @@ import (
 	"sort"
 
 	"golang.org/x/exp/maps"
+	discoveryv1 "k8s.io/api/discovery/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/utils/ptr"
 	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
@@ -224,6 +226,9 @@ func (t *Translator) Translate(resources *resource.Resources) (*TranslateResult,
 	extServerPolicies, translateErrs := t.ProcessExtensionServerPolicies(
 		resources.ExtensionServerPolicies, gateways, xdsIR)
 
+	// Process the Envoy proxies of the Gateways that use zone-aware routing.
+	t.ProcessLocalClusters(gateways, xdsIR, infraIR, resources)
+
 	// Sort xdsIR based on the Gateway API spec
 	sortXdsIRMap(xdsIR)
 
@@ -299,6 +304,73 @@ func (t *Translator) InitIRs(gateways []*GatewayContext) (map[string]*ir.Xds, ma
 	return xdsIR, infraIR
 }
 
+// ProcessLocalClusters sets the endpoints of the Envoy proxies of the Gateways that
+// use zone-aware routing, read from the EndpointSlices of the Envoy services.
+// The local cluster is set even if there isn't any EndpointSlice yet, so that the
+// local cluster of the Envoy bootstrap is always answered.
+// The endpoints that aren't ready yet are kept, so that a starting Envoy proxy finds
+// itself in the local cluster.
+func (t *Translator) ProcessLocalClusters(gateways []*GatewayContext, xdsIR resource.XdsIRMap,
+	infraIR resource.InfraIRMap, resources *resource.Resources,
+) {
+	for _, gateway := range gateways {
+		irKey := t.getIRKey(gateway.Gateway)
+		if xdsIR[irKey].LocalCluster != nil || !usesZoneAwareRouting(xdsIR[irKey]) {
+			continue
+		}
+		infraIR[irKey].Proxy.ZoneAwareRouting = true
+
+		selector := labels.SelectorFromSet(OwnerLabels(gateway.Gateway, t.MergeGateways))
+		localCluster := &ir.LocalCluster{}
+		for _, endpointSlice := range resources.EndpointSlices {
+			if !selector.Matches(labels.Set(endpointSlice.Labels)) ||
+				endpointSlice.AddressType == discoveryv1.AddressTypeFQDN ||
+				len(endpointSlice.Ports) == 0 || endpointSlice.Ports[0].Port == nil {
+				continue
+			}
+			for _, endpoint := range endpointSlice.Endpoints {
+				if ptr.Deref(endpoint.Conditions.Terminating, false) {
+					continue
+				}
+				for _, address := range endpoint.Addresses {
+					ep := ir.NewDestEndpoint(address, uint32(*endpointSlice.Ports[0].Port))
+					ep.Zone = endpoint.Zone
+					localCluster.Endpoints = append(localCluster.Endpoints, ep)
+				}
+			}
+		}
+		xdsIR[irKey].LocalCluster = localCluster
+	}
+}
+
+// usesZoneAwareRouting returns true if a route of the provided IR prefers the
+// backend endpoints in the zone of the Envoy proxy.
+func usesZoneAwareRouting(xds *ir.Xds) bool {
+	preferLocal := func(lb *ir.LoadBalancer) bool {
+		return lb != nil && lb.ZoneAware != nil && lb.ZoneAware.PreferLocal != nil
+	}
+	for _, listener := range xds.HTTP {
+		for _, route := range listener.Routes {
+			if route.Traffic != nil && preferLocal(route.Traffic.LoadBalancer) {
+				return true
+			}
+		}
+	}
+	for _, listener := range xds.TCP {
+		for _, route := range listener.Routes {
+			if preferLocal(route.LoadBalancer) {
+				return true
+			}
+		}
+	}
+	for _, listener := range xds.UDP {
+		if listener.Route != nil && preferLocal(listener.Route.LoadBalancer) {
+			return true
+		}
+	}
+	return false
+}
+
 // IsEnvoyServiceRouting returns true if EnvoyProxy.Spec.RoutingType == ServiceRoutingType
 // or, alternatively, if Translator.EndpointRoutingDisabled has been explicitly set to true;
 // otherwise, it returns false.
@@ -870,6 +870,7 @@ func xdsWithoutEqual(a *ir.Xds) any {
 		UDP                []*ir.UDPListener
 		EnvoyPatchPolicies []*ir.EnvoyPatchPolicy
 		FilterOrder        []egv1a1.FilterPosition
+		LocalCluster       *ir.LocalCluster
 	}{
 		AccessLog:          a.AccessLog,
 		Tracing:            a.Tracing,
@@ -879,6 +880,7 @@ func xdsWithoutEqual(a *ir.Xds) any {
 		UDP:                a.UDP,
 		EnvoyPatchPolicies: a.EnvoyPatchPolicies,
 		FilterOrder:        a.FilterOrder,
+		LocalCluster:       a.LocalCluster,
 	}
 
 	// Ensure we didn't drop an exported field.
@@ -27,12 +27,18 @@ func BuildProxyArgs(
 	shutdownConfig *egv1a1.ShutdownConfig,
 	bootstrapConfigOptions *bootstrap.RenderBootstrapConfigOptions,
 	serviceNode string,
+	serviceZone string,
 ) ([]string, error) {
 	// If IPFamily is not set, try to determine it from the infrastructure.
 	if bootstrapConfigOptions != nil && bootstrapConfigOptions.IPFamily == nil {
 		bootstrapConfigOptions.IPFamily = getIPFamily(infra)
 	}
 
+	// The local cluster is only added to the bootstrap of the proxies that use zone-aware routing.
+	if bootstrapConfigOptions != nil {
+		bootstrapConfigOptions.LocalCluster = infra.ZoneAwareRouting
+	}
+
 	bootstrapConfigurations, err := bootstrap.GetRenderedBootstrapConfig(bootstrapConfigOptions)
 	if err != nil {
 		return nil, err
@@ -58,6 +64,14 @@ func BuildProxyArgs(
 		"--drain-strategy immediate",
 	}
 
+	// The zone of the Envoy proxy is the zone of its node locality, which is required
+	// by the zone-aware routing. The flag and its value are passed as separate arguments,
+	// so that an empty zone isn't parsed as a flag without value, which reads the value
+	// from the next argument.
+	if serviceZone != "" {
+		args = append(args, "--service-zone", serviceZone)
+	}
+
 	if infra.Config != nil &&
 		infra.Config.Spec.Concurrency != nil {
 		args = append(args, fmt.Sprintf("--concurrency %d", *infra.Config.Spec.Concurrency))
@@ -30,6 +30,8 @@ const (
 	envoyNsEnvVar = "ENVOY_GATEWAY_NAMESPACE"
 	// envoyPodEnvVar is the name of the Envoy pod name environment variable.
 	envoyPodEnvVar = "ENVOY_POD_NAME"
+	// envoyZoneEnvVar is the name of the Envoy pod zone environment variable.
+	envoyZoneEnvVar = "ENVOY_SERVICE_ZONE"
 )
 
 // ExpectedResourceHashedName returns expected resource hashed name including up to the 48 characters of the original name.
@@ -116,7 +118,7 @@ func expectedProxyContainers(infra *ir.ProxyInfra,
 		XdsServerHost:    ptr.To(fmt.Sprintf("%s.%s.svc.%s", config.EnvoyGatewayServiceName, namespace, dnsDomain)),
 	}
 
-	args, err := common.BuildProxyArgs(infra, shutdownConfig, bootstrapConfigOptions, fmt.Sprintf("$(%s)", envoyPodEnvVar))
+	args, err := common.BuildProxyArgs(infra, shutdownConfig, bootstrapConfigOptions, fmt.Sprintf("$(%s)", envoyPodEnvVar), fmt.Sprintf("$(%s)", envoyZoneEnvVar))
 	if err != nil {
 		return nil, err
 	}
@@ -344,6 +346,17 @@ func expectedContainerEnv(containerSpec *egv1a1.KubernetesContainerSpec) []corev
 				},
 			},
 		},
+		{
+			// The zone label is copied from the node by the PodTopologyLabelsAdmission plugin,
+			// and resolves to an empty zone if it's not set.
+			Name: envoyZoneEnvVar,
+			ValueFrom: &corev1.EnvVarSource{
+				FieldRef: &corev1.ObjectFieldSelector{
+					APIVersion: "v1",
+					FieldPath:  fmt.Sprintf("metadata.labels['%s']", corev1.LabelTopologyZone),
+				},
+			},
+		},
 	}
 
 	if containerSpec != nil {
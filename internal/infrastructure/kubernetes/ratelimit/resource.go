@@ -37,6 +37,27 @@ const (
 	RedisTLSClientKeyEnvVar = "REDIS_TLS_CLIENT_KEY"
 	// RedisTLSClientKeyFilename is the redis client key file.
 	RedisTLSClientKeyFilename = "/redis-certs/tls.key"
+	// RedisTypeEnvVar is the redis deployment type.
+	RedisTypeEnvVar = "REDIS_TYPE"
+	// RedisAuthEnvVar is the redis auth.
+	RedisAuthEnvVar = "REDIS_AUTH"
+	// RedisAuthPasswordEnvVar is the redis password read from the auth secret,
+	// which is referenced by RedisAuthEnvVar.
+	RedisAuthPasswordEnvVar = "REDIS_AUTH_PASSWORD"
+	// RedisAuthPasswordSecretKey is the key of the password in the redis auth secret.
+	RedisAuthPasswordSecretKey = "password"
+	// RedisPoolSizeEnvVar is the redis connection pool size.
+	RedisPoolSizeEnvVar = "REDIS_POOL_SIZE"
+	// RedisPipelineWindowEnvVar is the redis implicit pipeline window.
+	RedisPipelineWindowEnvVar = "REDIS_PIPELINE_WINDOW"
+	// RedisPipelineLimitEnvVar is the redis implicit pipeline limit.
+	RedisPipelineLimitEnvVar = "REDIS_PIPELINE_LIMIT"
+	// BackendTypeEnvVar is the database backend type.
+	BackendTypeEnvVar = "BACKEND_TYPE"
+	// MemcacheHostPortEnvVar is the comma separated memcached addresses.
+	MemcacheHostPortEnvVar = "MEMCACHE_HOST_PORT"
+	// MemcacheMaxIdleConnsEnvVar is the max idle connections per memcached server.
+	MemcacheMaxIdleConnsEnvVar = "MEMCACHE_MAX_IDLE_CONNS"
 	// RuntimeRootEnvVar is the runtime root.
 	RuntimeRootEnvVar = "RUNTIME_ROOT"
 	// RuntimeSubdirectoryEnvVar is the runtime subdirectory.
@@ -215,7 +236,7 @@ func expectedContainerVolumeMounts(rateLimit *egv1a1.RateLimit, rateLimitDeploym
 		})
 	}
 
-	if rateLimit.Backend.Redis.TLS != nil {
+	if rateLimit.Backend.Redis != nil && rateLimit.Backend.Redis.TLS != nil {
 		volumeMounts = append(volumeMounts, corev1.VolumeMount{
 			Name:      "redis-certs",
 			MountPath: "/redis-certs",
@@ -362,6 +383,27 @@ func expectedRateLimitContainerEnv(rateLimit *egv1a1.RateLimit, rateLimitDeploym
 				Value: rateLimit.Backend.Redis.URL,
 			},
 		}...)
+		env = append(env, expectedRedisEnv(rateLimit.Backend.Redis)...)
+	}
+
+	if rateLimit.Backend.Memcached != nil {
+		env = append(env, []corev1.EnvVar{
+			{
+				Name:  BackendTypeEnvVar,
+				Value: "memcache",
+			},
+			{
+				Name:  MemcacheHostPortEnvVar,
+				Value: strings.Join(rateLimit.Backend.Memcached.Addresses, ","),
+			},
+		}...)
+
+		if rateLimit.Backend.Memcached.MaxIdleConns != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  MemcacheMaxIdleConnsEnvVar,
+				Value: strconv.Itoa(int(*rateLimit.Backend.Memcached.MaxIdleConns)),
+			})
+		}
 	}
 
 	if rateLimit.Backend.Redis != nil && rateLimit.Backend.Redis.TLS != nil {
@@ -448,14 +490,91 @@ func expectedRateLimitContainerEnv(rateLimit *egv1a1.RateLimit, rateLimitDeploym
 	return resource.ExpectedContainerEnv(rateLimitDeployment.Container, env)
 }
 
-// Validate the ratelimit tls secret validating.
+// expectedRedisEnv returns the env of the redis deployment type, auth, pool
+// and pipeline settings.
+func expectedRedisEnv(redis *egv1a1.RateLimitRedisSettings) []corev1.EnvVar {
+	var env []corev1.EnvVar
+
+	if redis.Type != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  RedisTypeEnvVar,
+			Value: strings.ToUpper(string(*redis.Type)),
+		})
+	}
+
+	if redis.Auth != nil {
+		// The ratelimit service reads the credentials from a single env in the
+		// "password" or "username:password" format, so the password is read from
+		// the secret first and then expanded into it.
+		auth := fmt.Sprintf("$(%s)", RedisAuthPasswordEnvVar)
+		if redis.Auth.Username != nil {
+			auth = *redis.Auth.Username + ":" + auth
+		}
+		env = append(env, []corev1.EnvVar{
+			{
+				Name: RedisAuthPasswordEnvVar,
+				ValueFrom: &corev1.EnvVarSource{
+					SecretKeyRef: &corev1.SecretKeySelector{
+						LocalObjectReference: corev1.LocalObjectReference{
+							Name: string(redis.Auth.PasswordRef.Name),
+						},
+						Key: RedisAuthPasswordSecretKey,
+					},
+				},
+			},
+			{
+				Name:  RedisAuthEnvVar,
+				Value: auth,
+			},
+		}...)
+	}
+
+	if redis.PoolSize != nil {
+		env = append(env, corev1.EnvVar{
+			Name:  RedisPoolSizeEnvVar,
+			Value: strconv.Itoa(int(*redis.PoolSize)),
+		})
+	}
+
+	if redis.Pipeline != nil {
+		if redis.Pipeline.Window != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  RedisPipelineWindowEnvVar,
+				Value: string(*redis.Pipeline.Window),
+			})
+		}
+		if redis.Pipeline.Limit != nil {
+			env = append(env, corev1.EnvVar{
+				Name:  RedisPipelineLimitEnvVar,
+				Value: strconv.Itoa(int(*redis.Pipeline.Limit)),
+			})
+		}
+	}
+
+	return env
+}
+
+// Validate the ratelimit tls and auth secrets validating.
 func Validate(ctx context.Context, client client.Client, gateway *egv1a1.EnvoyGateway, namespace string) error {
-	if gateway.RateLimit.Backend.Redis != nil &&
-		gateway.RateLimit.Backend.Redis.TLS != nil &&
-		gateway.RateLimit.Backend.Redis.TLS.CertificateRef != nil {
-		certificateRef := gateway.RateLimit.Backend.Redis.TLS.CertificateRef
-		_, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, certificateRef, namespace)
-		return err
+	redis := gateway.RateLimit.Backend.Redis
+	if redis == nil {
+		return nil
+	}
+
+	if redis.TLS != nil && redis.TLS.CertificateRef != nil {
+		if _, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, redis.TLS.CertificateRef, namespace); err != nil {
+			return err
+		}
+	}
+
+	if redis.Auth != nil {
+		secret, _, err := kubernetes.ValidateSecretObjectReference(ctx, client, &redis.Auth.PasswordRef, namespace)
+		if err != nil {
+			return err
+		}
+		if _, ok := secret.Data[RedisAuthPasswordSecretKey]; !ok {
+			return fmt.Errorf("secret %s does not contain the %s key", secret.Name, RedisAuthPasswordSecretKey)
+		}
 	}
 
 	return nil
@@ -30,11 +30,6 @@ import (
 
 var overrideTestData = flag.Bool("override-testdata", false, "if override the test output data.")
 
-const (
-	// RedisAuthEnvVar is the redis auth.
-	RedisAuthEnvVar = "REDIS_AUTH"
-)
-
 var ownerReferenceUID = map[string]types.UID{
 	ResourceKindService:        "test-owner-reference-uid-for-service",
 	ResourceKindDeployment:     "test-owner-reference-uid-for-deployment",
@@ -723,6 +718,41 @@ func TestDeployment(t *testing.T) {
 				},
 			},
 		},
+		{
+			caseName: "redis-sentinel-settings",
+			rateLimit: &egv1a1.RateLimit{
+				Backend: egv1a1.RateLimitDatabaseBackend{
+					Type: egv1a1.RedisBackendType,
+					Redis: &egv1a1.RateLimitRedisSettings{
+						URL:      "mymaster,sentinel-0.redis.svc:26379,sentinel-1.redis.svc:26379",
+						Type:     ptr.To(egv1a1.RedisTypeSentinel),
+						PoolSize: ptr.To[int32](20),
+						Pipeline: &egv1a1.RedisPipeline{
+							Window: ptr.To(gwapiv1.Duration("150us")),
+							Limit:  ptr.To[int32](8),
+						},
+						Auth: &egv1a1.RedisAuth{
+							Username: ptr.To("ratelimit"),
+							PasswordRef: gwapiv1.SecretObjectReference{
+								Name: "redis-auth",
+							},
+						},
+					},
+				},
+			},
+		},
+		{
+			caseName: "memcached-settings",
+			rateLimit: &egv1a1.RateLimit{
+				Backend: egv1a1.RateLimitDatabaseBackend{
+					Type: egv1a1.MemcachedBackendType,
+					Memcached: &egv1a1.RateLimitMemcachedSettings{
+						Addresses:    []string{"memcached-0.memcached.svc:11211", "memcached-1.memcached.svc:11211"},
+						MaxIdleConns: ptr.To[int32](10),
+					},
+				},
+			},
+		},
 	}
 	for _, tc := range cases {
 		t.Run(tc.caseName, func(t *testing.T) {
@@ -54,6 +54,9 @@ type ProxyInfra struct {
 	// Addresses contain the external addresses this gateway has been
 	// requested to be available at.
 	Addresses []string `json:"addresses,omitempty" yaml:"addresses,omitempty"`
+	// ZoneAwareRouting is true if a route of the proxy uses zone-aware routing,
+	// which requires the local cluster in the Envoy bootstrap.
+	ZoneAwareRouting bool `json:"zoneAwareRouting,omitempty" yaml:"zoneAwareRouting,omitempty"`
 }
 
 // InfraMetadata defines metadata for the managed proxy infrastructure.
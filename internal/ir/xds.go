@@ -43,6 +43,8 @@ var (
 	ErrRouteNameEmpty                          = errors.New("field Name must be specified")
 	ErrHTTPRouteHostnameEmpty                  = errors.New("field Hostname must be specified")
 	ErrDestinationNameEmpty                    = errors.New("field Name must be specified")
+	ErrMirrorDestinationEmpty                  = errors.New("field Destination must be specified for a mirror")
+	ErrMirrorPercentageInvalid                 = errors.New("field Percentage of a mirror must be between 0 and 100")
 	ErrDestEndpointHostInvalid                 = errors.New("field Address must be a valid IP or FQDN address")
 	ErrDestEndpointPortInvalid                 = errors.New("field Port specified is invalid")
 	ErrDestEndpointUDSPortInvalid              = errors.New("field Port must not be specified for Unix Domain Socket address")
@@ -143,6 +145,8 @@ type Xds struct {
 	EnvoyPatchPolicies []*EnvoyPatchPolicy `json:"envoyPatchPolicies,omitempty" yaml:"envoyPatchPolicies,omitempty"`
 	// FilterOrder holds the custom order of the HTTP filters
 	FilterOrder []egv1a1.FilterPosition `json:"filterOrder,omitempty" yaml:"filterOrder,omitempty"`
+	// LocalCluster holds the Envoy proxies of the gateway, which are used by the zone-aware routing.
+	LocalCluster *LocalCluster `json:"localCluster,omitempty" yaml:"localCluster,omitempty"`
 }
 
 // Equal implements the Comparable interface used by watchable.DeepEqual to skip unnecessary updates.
@@ -672,11 +676,13 @@ type HTTPRoute struct {
 	// Redirections to be returned for this route. Takes precedence over Destinations.
 	Redirect *Redirect `json:"redirect,omitempty" yaml:"redirect,omitempty"`
 	// Destination that requests to this HTTPRoute will be mirrored to
-	Mirrors []*RouteDestination `json:"mirrors,omitempty" yaml:"mirrors,omitempty"`
+	Mirrors []*MirrorPolicy `json:"mirrors,omitempty" yaml:"mirrors,omitempty"`
 	// Destination associated with this matched route.
 	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
 	// Rewrite to be changed for this route.
 	URLRewrite *URLRewrite `json:"urlRewrite,omitempty" yaml:"urlRewrite,omitempty"`
+	// CredentialInjection defines the credential to be injected into the request before it's forwarded.
+	CredentialInjection *CredentialInjection `json:"credentialInjection,omitempty" yaml:"credentialInjection,omitempty"`
 	// ExtensionRefs holds unstructured resources that were introduced by an extension and used on the HTTPRoute as extensionRef filters
 	ExtensionRefs []*UnstructuredRef `json:"extensionRefs,omitempty" yaml:"extensionRefs,omitempty"`
 	// Traffic holds the features associated with BackendTrafficPolicy
@@ -762,6 +768,23 @@ type TrafficFeatures struct {
 	DNS *DNS `json:"dns,omitempty" yaml:"dns,omitempty"`
 	// ResponseOverride defines the schema for overriding the response.
 	ResponseOverride *ResponseOverride `json:"responseOverride,omitempty" yaml:"responseOverride,omitempty"`
+	// OAuth2ClientCredentials defines the configuration for obtaining an OAuth2 access token
+	// and attaching it to the requests forwarded to the backend.
+	OAuth2ClientCredentials *OAuth2ClientCredentials `json:"oauth2ClientCredentials,omitempty" yaml:"oauth2ClientCredentials,omitempty"`
+	// AdaptiveConcurrency defines the configuration for dynamically limiting the number
+	// of concurrent requests to the backend.
+	AdaptiveConcurrency *AdaptiveConcurrency `json:"adaptiveConcurrency,omitempty" yaml:"adaptiveConcurrency,omitempty"`
+	// AdmissionControl defines the configuration for rejecting requests when the
+	// success rate of the backend drops.
+	AdmissionControl *AdmissionControl `json:"admissionControl,omitempty" yaml:"admissionControl,omitempty"`
+	// Compression defines the compressors used to compress the responses, in order of preference.
+	Compression []*Compression `json:"compression,omitempty" yaml:"compression,omitempty"`
+	// Cache defines the configuration for caching the responses.
+	Cache *Cache `json:"cache,omitempty" yaml:"cache,omitempty"`
+	// RequestBuffer defines the configuration for buffering the request bodies.
+	RequestBuffer *RequestBuffer `json:"requestBuffer,omitempty" yaml:"requestBuffer,omitempty"`
+	// BandwidthLimit defines the configuration for limiting the bandwidth.
+	BandwidthLimit *BandwidthLimit `json:"bandwidthLimit,omitempty" yaml:"bandwidthLimit,omitempty"`
 }
 
 func (b *TrafficFeatures) Validate() error {
@@ -786,12 +809,18 @@ func (b *TrafficFeatures) Validate() error {
 type SecurityFeatures struct {
 	// CORS policy for the route.
 	CORS *CORS `json:"cors,omitempty" yaml:"cors,omitempty"`
+	// CSRF policy for the route.
+	CSRF *CSRF `json:"csrf,omitempty" yaml:"csrf,omitempty"`
 	// JWT defines the schema for authenticating HTTP requests using JSON Web Tokens (JWT).
 	JWT *JWT `json:"jwt,omitempty" yaml:"jwt,omitempty"`
 	// OIDC defines the schema for authenticating HTTP requests using OpenID Connect (OIDC).
 	OIDC *OIDC `json:"oidc,omitempty" yaml:"oidc,omitempty"`
 	// BasicAuth defines the schema for the HTTP Basic Authentication.
 	BasicAuth *BasicAuth `json:"basicAuth,omitempty" yaml:"basicAuth,omitempty"`
+	// APIKeyAuth defines the schema for the API Key Authentication.
+	APIKeyAuth *APIKeyAuth `json:"apiKeyAuth,omitempty" yaml:"apiKeyAuth,omitempty"`
+	// HMACAuth defines the schema for the HMAC request signature verification.
+	HMACAuth *HMACAuth `json:"hmacAuth,omitempty" yaml:"hmacAuth,omitempty"`
 	// ExtAuth defines the schema for the external authorization.
 	ExtAuth *ExtAuth `json:"extAuth,omitempty" yaml:"extAuth,omitempty"`
 	// Authorization defines the schema for the authorization.
@@ -817,6 +846,10 @@ type EnvoyExtensionFeatures struct {
 	ExtProcs []ExtProc `json:"extProcs,omitempty" yaml:"extProcs,omitempty"`
 	// Wasm extensions
 	Wasms []Wasm `json:"wasms,omitempty" yaml:"wasms,omitempty"`
+	// Lua extensions
+	Luas []Lua `json:"luas,omitempty" yaml:"luas,omitempty"`
+	// gRPC-JSON transcoder
+	GRPCJSONTranscoder *GRPCJSONTranscoder `json:"grpcJSONTranscoder,omitempty" yaml:"grpcJSONTranscoder,omitempty"`
 }
 
 // UnstructuredRef holds unstructured data for an arbitrary k8s resource introduced by an extension
@@ -858,6 +891,17 @@ type CORS struct {
 	AllowCredentials bool `json:"allowCredentials,omitempty" yaml:"allowCredentials,omitempty"`
 }
 
+// CSRF holds the Cross-Site Request Forgery (CSRF) protection settings.
+//
+// +k8s:deepcopy-gen=true
+type CSRF struct {
+	// AdditionalOrigins defines the additional origins that are trusted to make
+	// state-changing requests.
+	AdditionalOrigins []*StringMatch `json:"additionalOrigins,omitempty" yaml:"additionalOrigins,omitempty"`
+	// Shadow enables the shadow (report-only) mode.
+	Shadow bool `json:"shadow,omitempty" yaml:"shadow,omitempty"`
+}
+
 // JWT defines the schema for authenticating HTTP requests using
 // JSON Web Tokens (JWT).
 //
@@ -970,6 +1014,86 @@ type BasicAuth struct {
 	Users PrivateBytes `json:"users,omitempty" yaml:"users,omitempty"`
 }
 
+// APIKeyAuth defines the schema for the API Key Authentication.
+//
+// +k8s:deepcopy-gen=true
+type APIKeyAuth struct {
+	// Name is a unique name for an APIKeyAuth configuration.
+	// The xds translator only generates one api key auth filter for each unique name.
+	Name string `json:"name" yaml:"name"`
+
+	// Credentials maps the client ids to their API keys.
+	Credentials map[string]PrivateBytes `json:"credentials,omitempty" yaml:"credentials,omitempty"`
+
+	// ExtractFrom is where to fetch the key from the coming request.
+	// The value from the first source that has a key will be used.
+	ExtractFrom []*ExtractFrom `json:"extractFrom,omitempty" yaml:"extractFrom,omitempty"`
+
+	// ForwardClientIDHeader is the name of the header to forward the client identity
+	// to the backend service.
+	ForwardClientIDHeader *string `json:"forwardClientIDHeader,omitempty" yaml:"forwardClientIDHeader,omitempty"`
+}
+
+// HMACAuth defines the schema for the HMAC request signature verification.
+//
+// +k8s:deepcopy-gen=true
+type HMACAuth struct {
+	// Name is a unique name for an HMACAuth configuration.
+	Name string `json:"name" yaml:"name"`
+
+	// Secret is the shared secret used to sign the requests.
+	Secret PrivateBytes `json:"secret,omitempty" yaml:"secret,omitempty"`
+
+	// SignatureHeader is the name of the request header that carries the signature.
+	SignatureHeader string `json:"signatureHeader" yaml:"signatureHeader"`
+
+	// SignaturePrefix is the prefix of the signature header value that is stripped
+	// before the signature is compared.
+	SignaturePrefix string `json:"signaturePrefix,omitempty" yaml:"signaturePrefix,omitempty"`
+
+	// Algorithm is the hash algorithm used to compute the signature.
+	Algorithm egv1a1.HMACAlgorithm `json:"algorithm" yaml:"algorithm"`
+
+	// Encoding is the encoding of the signature.
+	Encoding egv1a1.HMACSignatureEncoding `json:"encoding" yaml:"encoding"`
+
+	// Timestamp defines the verification of the time at which the request was signed.
+	Timestamp *HMACTimestamp `json:"timestamp,omitempty" yaml:"timestamp,omitempty"`
+
+	// MaxRequestBytes is the maximum size of a request body that is buffered to
+	// verify the signature.
+	MaxRequestBytes uint32 `json:"maxRequestBytes" yaml:"maxRequestBytes"`
+}
+
+// HMACTimestamp defines the verification of the time at which a request was signed.
+//
+// +k8s:deepcopy-gen=true
+type HMACTimestamp struct {
+	// Header is the name of the request header that carries the timestamp.
+	Header string `json:"header" yaml:"header"`
+
+	// MaxSkew is the maximum allowed difference between the timestamp and the current time.
+	MaxSkew metav1.Duration `json:"maxSkew" yaml:"maxSkew"`
+
+	// Separator is the string between the timestamp and the request body in the signed payload.
+	Separator string `json:"separator" yaml:"separator"`
+
+	// PayloadPrefix is the string that precedes the timestamp in the signed payload.
+	PayloadPrefix string `json:"payloadPrefix,omitempty" yaml:"payloadPrefix,omitempty"`
+}
+
+// ExtractFrom is where to fetch the key from the coming request.
+//
+// +k8s:deepcopy-gen=true
+type ExtractFrom struct {
+	// Headers is the names of the header to fetch the key from.
+	Headers []string `json:"headers,omitempty" yaml:"headers,omitempty"`
+	// Params is the names of the query parameter to fetch the key from.
+	Params []string `json:"params,omitempty" yaml:"params,omitempty"`
+	// Cookies is the names of the cookie to fetch the key from.
+	Cookies []string `json:"cookies,omitempty" yaml:"cookies,omitempty"`
+}
+
 // ExtAuth defines the schema for the external authorization.
 //
 // +k8s:deepcopy-gen=true
@@ -1020,6 +1144,36 @@ type ExtAuth struct {
 	// the new matched route will be applied.
 	// +optional
 	RecomputeRoute *bool `json:"recomputeRoute,omitempty"`
+
+	// ContextExtensions defines the static context attached to the request
+	// to the external authorization service.
+	// +optional
+	ContextExtensions []*ExtAuthContextExtension `json:"contextExtensions,omitempty"`
+
+	// DecisionCache defines the cache of the authorization decisions.
+	// +optional
+	DecisionCache *ExtAuthDecisionCache `json:"decisionCache,omitempty"`
+}
+
+// ExtAuthContextExtension defines a key-value pair attached to the request to
+// the external authorization service.
+// +k8s:deepcopy-gen=true
+type ExtAuthContextExtension struct {
+	// Name of the context extension.
+	Name string `json:"name"`
+	// Value of the context extension.
+	Value string `json:"value"`
+}
+
+// ExtAuthDecisionCache defines the cache of the external authorization decisions.
+// +k8s:deepcopy-gen=true
+type ExtAuthDecisionCache struct {
+	// Headers are the client request headers whose values form the cache key.
+	Headers []string `json:"headers"`
+	// TTL is the duration for which a decision is cached.
+	TTL metav1.Duration `json:"ttl"`
+	// MaxEntries is the maximum number of decisions cached by each Envoy worker thread.
+	MaxEntries uint32 `json:"maxEntries"`
 }
 
 // BodyToExtAuth defines the Body to Ext Auth configuration
@@ -1089,10 +1243,29 @@ type AuthorizationRule struct {
 	// Action defines the action to be taken if the rule matches.
 	Action egv1a1.AuthorizationAction `json:"action"`
 
+	// Operation defines the request attributes to be matched.
+	Operation *Operation `json:"operation,omitempty"`
+
 	// Principal defines the principal to be matched.
 	Principal Principal `json:"principal"`
 }
 
+// Operation defines the schema for the request attributes of an authorization rule.
+//
+// +k8s:deepcopy-gen=true
+type Operation struct {
+	// Methods defines the HTTP methods to be matched.
+	Methods []string `json:"methods,omitempty"`
+	// Paths defines the request paths to be matched.
+	Paths []*StringMatch `json:"paths,omitempty"`
+	// Headers defines the request headers to be matched.
+	Headers []*StringMatch `json:"headers,omitempty"`
+	// Hosts defines the request hosts to be matched.
+	Hosts []*StringMatch `json:"hosts,omitempty"`
+	// ServerNames defines the TLS server names (SNI) to be matched.
+	ServerNames []*StringMatch `json:"serverNames,omitempty"`
+}
+
 // Principal defines the schema for the principal.
 //
 // +k8s:deepcopy-gen=true
@@ -1101,6 +1274,128 @@ type Principal struct {
 	ClientCIDRs []*CIDRMatch `json:"clientCIDRs,omitempty"`
 	// JWT defines the JWT principal to be matched.
 	JWT *egv1a1.JWTPrincipal `json:"jwt,omitempty"`
+	// ClientCertificate defines the client certificate principal to be matched.
+	ClientCertificate *ClientCertificatePrincipal `json:"clientCertificate,omitempty"`
+}
+
+// ClientCertificatePrincipal defines the identities in the client certificate to be matched.
+//
+// +k8s:deepcopy-gen=true
+type ClientCertificatePrincipal struct {
+	// URISANs defines the URI SANs to be matched.
+	URISANs []*StringMatch `json:"uriSANs,omitempty"`
+	// DNSSANs defines the DNS SANs to be matched.
+	DNSSANs []*StringMatch `json:"dnsSANs,omitempty"`
+	// Subjects defines the certificate subjects to be matched.
+	Subjects []*StringMatch `json:"subjects,omitempty"`
+}
+
+// AdaptiveConcurrency defines the schema for the adaptive concurrency limiting.
+//
+// +k8s:deepcopy-gen=true
+type AdaptiveConcurrency struct {
+	// SampleAggregatePercentile is the percentile of the sampled latencies used to compute the limit.
+	SampleAggregatePercentile *uint32 `json:"sampleAggregatePercentile,omitempty" yaml:"sampleAggregatePercentile,omitempty"`
+	// SampleWindow is the period of time the latencies are sampled before recalculating the limit.
+	SampleWindow *metav1.Duration `json:"sampleWindow,omitempty" yaml:"sampleWindow,omitempty"`
+	// MaxConcurrencyLimit is the upper bound of the calculated limit.
+	MaxConcurrencyLimit *uint32 `json:"maxConcurrencyLimit,omitempty" yaml:"maxConcurrencyLimit,omitempty"`
+	// MinRTT defines how the minimum round-trip time is calculated.
+	MinRTT *AdaptiveConcurrencyMinRTT `json:"minRTT,omitempty" yaml:"minRTT,omitempty"`
+}
+
+// AdaptiveConcurrencyMinRTT defines the schema for the minimum round-trip time calculation.
+//
+// +k8s:deepcopy-gen=true
+type AdaptiveConcurrencyMinRTT struct {
+	// Interval is the time between two minRTT recalculations.
+	Interval *metav1.Duration `json:"interval,omitempty" yaml:"interval,omitempty"`
+	// FixedValue is a fixed minRTT that disables the minRTT sampling.
+	FixedValue *metav1.Duration `json:"fixedValue,omitempty" yaml:"fixedValue,omitempty"`
+	// RequestCount is the number of requests sampled during a recalculation.
+	RequestCount *uint32 `json:"requestCount,omitempty" yaml:"requestCount,omitempty"`
+	// Jitter is the random delay added to the recalculations, as a percentage of the interval.
+	Jitter *uint32 `json:"jitter,omitempty" yaml:"jitter,omitempty"`
+	// MinConcurrency is the limit applied during a recalculation.
+	MinConcurrency *uint32 `json:"minConcurrency,omitempty" yaml:"minConcurrency,omitempty"`
+	// Buffer is added to the measured minRTT, as a percentage of it.
+	Buffer *uint32 `json:"buffer,omitempty" yaml:"buffer,omitempty"`
+}
+
+// AdmissionControl defines the schema for the admission control.
+//
+// +k8s:deepcopy-gen=true
+type AdmissionControl struct {
+	// HTTPSuccessStatuses are the ranges of HTTP status codes considered successful.
+	HTTPSuccessStatuses []StatusCodeRange `json:"httpSuccessStatuses,omitempty" yaml:"httpSuccessStatuses,omitempty"`
+	// GRPCSuccessStatuses are the gRPC status codes considered successful.
+	GRPCSuccessStatuses []uint32 `json:"grpcSuccessStatuses,omitempty" yaml:"grpcSuccessStatuses,omitempty"`
+	// SamplingWindow is the sliding window over which the success rate is calculated.
+	SamplingWindow *metav1.Duration `json:"samplingWindow,omitempty" yaml:"samplingWindow,omitempty"`
+	// SuccessRateThreshold is the success rate percentage below which requests are rejected.
+	SuccessRateThreshold *uint32 `json:"successRateThreshold,omitempty" yaml:"successRateThreshold,omitempty"`
+	// Aggression controls how fast the rejection probability grows.
+	Aggression *float32 `json:"aggression,omitempty" yaml:"aggression,omitempty"`
+	// MinRPS is the minimum requests per second required before rejecting requests.
+	MinRPS *uint32 `json:"minRPS,omitempty" yaml:"minRPS,omitempty"`
+	// MaxRejectionProbability is the upper bound of the rejection probability, as a percentage.
+	MaxRejectionProbability *uint32 `json:"maxRejectionProbability,omitempty" yaml:"maxRejectionProbability,omitempty"`
+}
+
+// Compression defines the schema for compressing the responses.
+//
+// +k8s:deepcopy-gen=true
+type Compression struct {
+	// Type is the compressor type.
+	Type egv1a1.CompressorType `json:"type" yaml:"type"`
+	// Gzip defines the settings of the Gzip compressor.
+	Gzip *egv1a1.GzipCompressor `json:"gzip,omitempty" yaml:"gzip,omitempty"`
+	// Brotli defines the settings of the Brotli compressor.
+	Brotli *egv1a1.BrotliCompressor `json:"brotli,omitempty" yaml:"brotli,omitempty"`
+	// Zstd defines the settings of the Zstd compressor.
+	Zstd *egv1a1.ZstdCompressor `json:"zstd,omitempty" yaml:"zstd,omitempty"`
+	// MinContentLength is the minimum response length, in bytes, to compress.
+	MinContentLength *uint32 `json:"minContentLength,omitempty" yaml:"minContentLength,omitempty"`
+	// ContentTypes are the response content types to compress.
+	ContentTypes []string `json:"contentTypes,omitempty" yaml:"contentTypes,omitempty"`
+}
+
+// BandwidthLimit defines the schema for limiting the bandwidth.
+//
+// +k8s:deepcopy-gen=true
+type BandwidthLimit struct {
+	// Direction is the direction of the traffic whose bandwidth is limited.
+	Direction egv1a1.BandwidthLimitDirection `json:"direction" yaml:"direction"`
+	// LimitKbps is the bandwidth limit in KiB per second.
+	LimitKbps uint64 `json:"limitKbps" yaml:"limitKbps"`
+	// FillInterval is the interval at which the bandwidth is refilled.
+	FillInterval *metav1.Duration `json:"fillInterval,omitempty" yaml:"fillInterval,omitempty"`
+}
+
+// RequestBuffer defines the schema for buffering the request bodies.
+//
+// +k8s:deepcopy-gen=true
+type RequestBuffer struct {
+	// LimitBytes is the maximum size of a request body.
+	LimitBytes uint32 `json:"limitBytes" yaml:"limitBytes"`
+}
+
+// Cache defines the schema for caching the responses.
+//
+// +k8s:deepcopy-gen=true
+type Cache struct {
+	// MaxSizeBytes is the maximum total size of the responses cached for the route.
+	MaxSizeBytes *uint64 `json:"maxSizeBytes,omitempty" yaml:"maxSizeBytes,omitempty"`
+	// MaxBodyBytes is the maximum size of a response body to be cached.
+	MaxBodyBytes *uint32 `json:"maxBodyBytes,omitempty" yaml:"maxBodyBytes,omitempty"`
+	// Methods are the request methods whose responses can be cached.
+	Methods []string `json:"methods,omitempty" yaml:"methods,omitempty"`
+	// StatusCodes are the response status codes that can be cached.
+	StatusCodes []HTTPStatus `json:"statusCodes,omitempty" yaml:"statusCodes,omitempty"`
+	// VaryHeaders are the request headers that the responses can vary on.
+	VaryHeaders []string `json:"varyHeaders,omitempty" yaml:"varyHeaders,omitempty"`
+	// TTL overrides the freshness lifetime of the responses.
+	TTL *metav1.Duration `json:"ttl,omitempty" yaml:"ttl,omitempty"`
 }
 
 // FaultInjection defines the schema for injecting faults into requests.
@@ -1256,6 +1551,31 @@ func (j *JWT) Validate() error {
 	return errs
 }
 
+// MirrorPolicy specifies a destination to mirror the requests to, in addition
+// to the original destination of the route.
+// +k8s:deepcopy-gen=true
+type MirrorPolicy struct {
+	// Destination is the destination the requests are mirrored to.
+	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
+	// Percentage of the requests to mirror. All the requests are mirrored if unset.
+	Percentage *float32 `json:"percentage,omitempty" yaml:"percentage,omitempty"`
+}
+
+// Validate the fields within the MirrorPolicy structure
+func (m *MirrorPolicy) Validate() error {
+	var errs error
+	if m.Destination == nil {
+		errs = errors.Join(errs, ErrMirrorDestinationEmpty)
+	} else if err := m.Destination.Validate(); err != nil {
+		errs = errors.Join(errs, err)
+	}
+	if m.Percentage != nil && (*m.Percentage < 0 || *m.Percentage > 100) {
+		errs = errors.Join(errs, ErrMirrorPercentageInvalid)
+	}
+
+	return errs
+}
+
 // RouteDestination holds the destination details associated with the route
 // +kubebuilder:object:generate=true
 type RouteDestination struct {
@@ -1354,6 +1674,8 @@ type DestinationEndpoint struct {
 	Port uint32 `json:"port" yaml:"port"`
 	// Path refers to the Unix Domain Socket
 	Path *string `json:"path,omitempty" yaml:"path,omitempty"`
+	// Zone refers to the zone of the endpoint, if known.
+	Zone *string `json:"zone,omitempty" yaml:"zone,omitempty"`
 }
 
 // Validate the fields within the DestinationEndpoint structure
@@ -1410,6 +1732,45 @@ func (h AddHeader) Validate() error {
 	return errs
 }
 
+// CredentialInjection holds the configuration for injecting a credential into a request.
+// +k8s:deepcopy-gen=true
+type CredentialInjection struct {
+	// Name is a unique name for the CredentialInjection configuration.
+	// The xds translator only generates one credential injector filter for each unique name.
+	Name string `json:"name" yaml:"name"`
+	// Header is the name of the header where the credential is injected.
+	Header string `json:"header" yaml:"header"`
+	// Overwrite defines whether to overwrite the header if it already exists in the request.
+	Overwrite bool `json:"overwrite,omitempty" yaml:"overwrite,omitempty"`
+	// Credential is the credential to be injected.
+	Credential PrivateBytes `json:"credential,omitempty" yaml:"credential,omitempty"`
+}
+
+// OAuth2ClientCredentials holds the configuration for obtaining an OAuth2 access token with
+// the client credentials grant, and injecting it into the requests forwarded to the backend.
+// +k8s:deepcopy-gen=true
+type OAuth2ClientCredentials struct {
+	// Name is a unique name for the OAuth2ClientCredentials configuration.
+	// The xds translator only generates one credential injector filter for each unique name.
+	Name string `json:"name" yaml:"name"`
+	// TokenEndpoint is the URL of the OAuth2 token endpoint.
+	TokenEndpoint string `json:"tokenEndpoint" yaml:"tokenEndpoint"`
+	// Destination defines the destination for the token endpoint.
+	Destination *RouteDestination `json:"destination,omitempty" yaml:"destination,omitempty"`
+	// Traffic contains configuration for traffic features for the token endpoint.
+	Traffic *TrafficFeatures `json:"traffic,omitempty" yaml:"traffic,omitempty"`
+	// ClientID is the client identifier sent to the token endpoint.
+	ClientID string `json:"clientID" yaml:"clientID"`
+	// ClientSecret is the client secret sent to the token endpoint.
+	ClientSecret PrivateBytes `json:"clientSecret,omitempty" yaml:"clientSecret,omitempty"`
+	// Scopes is the list of scopes requested in the access token request.
+	Scopes []string `json:"scopes,omitempty" yaml:"scopes,omitempty"`
+	// AuthenticationMethod defines how the client credentials are sent to the token endpoint.
+	AuthenticationMethod egv1a1.OAuth2ClientAuthenticationMethod `json:"authenticationMethod,omitempty" yaml:"authenticationMethod,omitempty"`
+	// TokenFetchRetryInterval is the interval between two attempts to fetch the access token.
+	TokenFetchRetryInterval *metav1.Duration `json:"tokenFetchRetryInterval,omitempty" yaml:"tokenFetchRetryInterval,omitempty"`
+}
+
 // URLRewrite holds the details for how to rewrite a request
 // +k8s:deepcopy-gen=true
 type URLRewrite struct {
@@ -1829,6 +2190,10 @@ type GlobalRateLimit struct {
 
 	// Rules for rate limiting.
 	Rules []*RateLimitRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+
+	// ShadowMode means that the rate limit service counts the requests against
+	// the limits, but never rejects them.
+	ShadowMode bool `json:"shadowMode,omitempty" yaml:"shadowMode,omitempty"`
 }
 
 // LocalRateLimit holds the local rate limiting configuration.
@@ -1840,6 +2205,10 @@ type LocalRateLimit struct {
 
 	// Rules for rate limiting.
 	Rules []*RateLimitRule `json:"rules,omitempty" yaml:"rules,omitempty"`
+
+	// ShadowMode means that the requests are counted against the limits, but
+	// never rejected.
+	ShadowMode bool `json:"shadowMode,omitempty" yaml:"shadowMode,omitempty"`
 }
 
 // RateLimitRule holds the match and limit configuration for ratelimiting.
@@ -1849,8 +2218,51 @@ type RateLimitRule struct {
 	HeaderMatches []*StringMatch `json:"headerMatches" yaml:"headerMatches"`
 	// CIDRMatch define the match conditions on the source IP's CIDR for this route.
 	CIDRMatch *CIDRMatch `json:"cidrMatch,omitempty" yaml:"cidrMatch,omitempty"`
+	// MethodMatches define the request methods for this route. The request
+	// matches if its method is one of them.
+	MethodMatches []string `json:"methodMatches,omitempty" yaml:"methodMatches,omitempty"`
+	// PathMatch defines the match condition on the request path, excluding the
+	// query string, for this route.
+	PathMatch *StringMatch `json:"pathMatch,omitempty" yaml:"pathMatch,omitempty"`
+	// QueryParamMatches define the match conditions on the request query parameters
+	// for this route.
+	QueryParamMatches []*StringMatch `json:"queryParamMatches,omitempty" yaml:"queryParamMatches,omitempty"`
+	// JWTClaimMatches define the match conditions on the JWT claims for this route.
+	JWTClaimMatches []*JWTClaimMatch `json:"jwtClaimMatches,omitempty" yaml:"jwtClaimMatches,omitempty"`
 	// Limit holds the rate limit values.
 	Limit RateLimitValue `json:"limit,omitempty" yaml:"limit,omitempty"`
+	// RequestCost specifies the number added to the rate limit counters for a
+	// request before it's forwarded. If unset, the cost of a request is 1.
+	RequestCost *RateLimitCost `json:"requestCost,omitempty" yaml:"requestCost,omitempty"`
+	// ResponseCost specifies the number added to the rate limit counters after
+	// the response is completed.
+	ResponseCost *RateLimitCost `json:"responseCost,omitempty" yaml:"responseCost,omitempty"`
+}
+
+// RateLimitCost specifies the number added to the rate limit counters.
+// Only one of Number or Format is set.
+// +k8s:deepcopy-gen=true
+type RateLimitCost struct {
+	// Number is a fixed cost.
+	Number *uint64 `json:"number,omitempty" yaml:"number,omitempty"`
+	// Format is a substitution format string that evaluates to the cost, for
+	// example, "%REQ(x-cost)%".
+	Format *string `json:"format,omitempty" yaml:"format,omitempty"`
+}
+
+// JWTClaimMatch defines the match condition on a JWT claim.
+// +k8s:deepcopy-gen=true
+type JWTClaimMatch struct {
+	// Provider is the name of the JWT provider that verified the token.
+	Provider string `json:"provider" yaml:"provider"`
+	// Claim is the path to the claim. Nested claims are represented as
+	// separate path segments.
+	Claim []string `json:"claim" yaml:"claim"`
+	// Value is the expected value of the claim.
+	Value *string `json:"value,omitempty" yaml:"value,omitempty"`
+	// Distinct means that each value of the claim is treated as a distinct client
+	// selector and uses a separate rate limit bucket/counter.
+	Distinct bool `json:"distinct,omitempty" yaml:"distinct,omitempty"`
 }
 
 type CIDRMatch struct {
@@ -1865,7 +2277,8 @@ type CIDRMatch struct {
 
 // TODO zhaohuabing: remove this function
 func (r *RateLimitRule) IsMatchSet() bool {
-	return len(r.HeaderMatches) != 0 || r.CIDRMatch != nil
+	return len(r.HeaderMatches) != 0 || r.CIDRMatch != nil || len(r.MethodMatches) != 0 ||
+		r.PathMatch != nil || len(r.QueryParamMatches) != 0 || len(r.JWTClaimMatches) != 0
 }
 
 type RateLimitUnit egv1a1.RateLimitUnit
@@ -2117,6 +2530,8 @@ type LoadBalancer struct {
 	Random *Random `json:"random,omitempty" yaml:"random,omitempty"`
 	// ConsistentHash load balancer policy
 	ConsistentHash *ConsistentHash `json:"consistentHash,omitempty" yaml:"consistentHash,omitempty"`
+	// ZoneAware defines the zone-aware routing settings
+	ZoneAware *ZoneAware `json:"zoneAware,omitempty" yaml:"zoneAware,omitempty"`
 }
 
 // Validate the fields within the LoadBalancer structure
@@ -2172,6 +2587,37 @@ type ConsistentHash struct {
 	TableSize *uint64        `json:"tableSize,omitempty" yaml:"tableSize,omitempty"`
 }
 
+// LocalCluster holds the endpoints of the Envoy proxies of a gateway.
+// The zone-aware routing compares the zones of the Envoy proxies with the zones of the
+// backend endpoints to decide how much traffic each zone can keep local.
+// +k8s:deepcopy-gen=true
+type LocalCluster struct {
+	// Endpoints of the Envoy proxies, with their zones.
+	Endpoints []*DestinationEndpoint `json:"endpoints,omitempty" yaml:"endpoints,omitempty"`
+}
+
+// ZoneAware defines the zone-aware routing settings.
+// +k8s:deepcopy-gen=true
+type ZoneAware struct {
+	// PreferLocal prefers the endpoints in the same zone as the Envoy proxy.
+	PreferLocal *PreferLocalZone `json:"preferLocal,omitempty" yaml:"preferLocal,omitempty"`
+	// WeightedZones distributes the requests between the zones according to their weights.
+	WeightedZones []WeightedZone `json:"weightedZones,omitempty" yaml:"weightedZones,omitempty"`
+}
+
+// PreferLocalZone defines the local zone preference settings.
+// +k8s:deepcopy-gen=true
+type PreferLocalZone struct {
+	// MinEndpointsThreshold is the minimum number of healthy endpoints required to prefer the local zone.
+	MinEndpointsThreshold *uint64 `json:"minEndpointsThreshold,omitempty" yaml:"minEndpointsThreshold,omitempty"`
+}
+
+// WeightedZone defines the weight of a zone.
+type WeightedZone struct {
+	Zone   string `json:"zone" yaml:"zone"`
+	Weight uint32 `json:"weight" yaml:"weight"`
+}
+
 // Header consistent hash type settings
 type Header struct {
 	Name string `json:"name" yaml:"name"`
@@ -2488,6 +2934,19 @@ type Retry struct {
 
 	// PerRetry is the retry policy to be applied per retry attempt.
 	PerRetry *PerRetryPolicy `json:"perRetry,omitempty"`
+
+	// Budget limits the concurrent retries to a percentage of the active requests.
+	Budget *RetryBudget `json:"budget,omitempty"`
+}
+
+// RetryBudget defines the retry budget of the requests to the backend.
+// +k8s:deepcopy-gen=true
+type RetryBudget struct {
+	// Percent is the limit on the concurrent retries, as a percentage of the active requests.
+	Percent *uint32 `json:"percent,omitempty"`
+
+	// MinConcurrency is the minimum number of concurrent retries allowed.
+	MinConcurrency *uint32 `json:"minConcurrency,omitempty"`
 }
 
 type TriggerEnum egv1a1.TriggerEnum
@@ -2522,6 +2981,9 @@ type PerRetryPolicy struct {
 	Timeout *metav1.Duration `json:"timeout,omitempty"`
 	// Backoff is the backoff policy to be applied per retry attempt.
 	BackOff *BackOffPolicy `json:"backOff,omitempty"`
+	// HedgeOnTimeout sends a hedged request when the per retry timeout is reached,
+	// without cancelling the attempts in flight.
+	HedgeOnTimeout bool `json:"hedgeOnTimeout,omitempty"`
 }
 
 // +k8s:deepcopy-gen=true
@@ -2660,6 +3122,41 @@ type Wasm struct {
 	HostKeys []string `json:"hostKeys,omitempty"`
 }
 
+// Lua holds the information associated with Lua extensions
+// +k8s:deepcopy-gen=true
+type Lua struct {
+	// Name is a unique name for the Lua configuration.
+	// The xds translator only generates one Lua filter for each unique name.
+	Name string `json:"name" yaml:"name"`
+
+	// Code is the Lua source code.
+	Code string `json:"code" yaml:"code"`
+
+	// FailOpen is a switch used to control the behavior when the Lua script fails
+	// while processing a request.
+	FailOpen bool `json:"failOpen,omitempty" yaml:"failOpen,omitempty"`
+}
+
+// GRPCJSONTranscoder holds the information associated with the gRPC-JSON transcoder
+// +k8s:deepcopy-gen=true
+type GRPCJSONTranscoder struct {
+	// Name is a unique name for the gRPC-JSON transcoder configuration.
+	// The xds translator only generates one gRPC-JSON transcoder filter for each unique name.
+	Name string `json:"name" yaml:"name"`
+
+	// ProtoDescriptorBin is the binary proto descriptor set of the gRPC services.
+	ProtoDescriptorBin []byte `json:"protoDescriptorBin" yaml:"protoDescriptorBin"`
+
+	// Services are the fully qualified names of the gRPC services to transcode.
+	Services []string `json:"services" yaml:"services"`
+
+	// PrintOptions controls how the gRPC responses are printed as JSON.
+	PrintOptions *egv1a1.GRPCJSONTranscoderPrintOptions `json:"printOptions,omitempty" yaml:"printOptions,omitempty"`
+
+	// RejectUnknownMethod rejects the requests that can't be mapped to a gRPC method.
+	RejectUnknownMethod bool `json:"rejectUnknownMethod,omitempty" yaml:"rejectUnknownMethod,omitempty"`
+}
+
 // HTTPWasmCode holds the information associated with the HTTP Wasm code source.
 // +k8s:deepcopy-gen=true
 type HTTPWasmCode struct {
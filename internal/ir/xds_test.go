@@ -504,7 +504,7 @@ var (
 				Providers: []egv1a1.JWTProvider{
 					{
 						Name: "test1",
-						RemoteJWKS: egv1a1.RemoteJWKS{
+						RemoteJWKS: &egv1a1.RemoteJWKS{
 							URI: "https://test1.local",
 						},
 					},
@@ -518,7 +518,15 @@ var (
 		PathMatch: &StringMatch{
 			Exact: ptr.To("mirrorfilter"),
 		},
-		Mirrors: []*RouteDestination{&happyRouteDestination},
+		Mirrors: []*MirrorPolicy{{Destination: &happyRouteDestination}},
+	}
+	requestMirrorFilterInvalidPercentage = HTTPRoute{
+		Name:     "mirrorfilter",
+		Hostname: "*",
+		PathMatch: &StringMatch{
+			Exact: ptr.To("mirrorfilter"),
+		},
+		Mirrors: []*MirrorPolicy{{Destination: &happyRouteDestination, Percentage: ptr.To[float32](150)}},
 	}
 
 	// RouteDestination
@@ -1002,6 +1010,11 @@ func TestValidateHTTPRoute(t *testing.T) {
 			input: requestMirrorFilter,
 			want:  nil,
 		},
+		{
+			name:  "mirror-filter-invalid-percentage",
+			input: requestMirrorFilterInvalidPercentage,
+			want:  []error{ErrMirrorPercentageInvalid},
+		},
 	}
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
@@ -1257,7 +1270,7 @@ func TestValidateJWT(t *testing.T) {
 						Name:      "test",
 						Issuer:    "https://test.local",
 						Audiences: []string{"test1", "test2"},
-						RemoteJWKS: egv1a1.RemoteJWKS{
+						RemoteJWKS: &egv1a1.RemoteJWKS{
 							URI: "https://test.local",
 						},
 					},
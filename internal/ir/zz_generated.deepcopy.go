@@ -94,6 +94,53 @@ func (in *ALSAccessLogHTTP) DeepCopy() *ALSAccessLogHTTP {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *APIKeyAuth) DeepCopyInto(out *APIKeyAuth) {
+	*out = *in
+	if in.Credentials != nil {
+		in, out := &in.Credentials, &out.Credentials
+		*out = make(map[string]PrivateBytes, len(*in))
+		for key, val := range *in {
+			var outVal []byte
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(PrivateBytes, len(*in))
+				copy(*out, *in)
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.ExtractFrom != nil {
+		in, out := &in.ExtractFrom, &out.ExtractFrom
+		*out = make([]*ExtractFrom, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ExtractFrom)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ForwardClientIDHeader != nil {
+		in, out := &in.ForwardClientIDHeader, &out.ForwardClientIDHeader
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new APIKeyAuth.
+func (in *APIKeyAuth) DeepCopy() *APIKeyAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(APIKeyAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AccessLog) DeepCopyInto(out *AccessLog) {
 	*out = *in
@@ -203,6 +250,86 @@ func (in *ActiveHealthCheck) DeepCopy() *ActiveHealthCheck {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrency) DeepCopyInto(out *AdaptiveConcurrency) {
+	*out = *in
+	if in.SampleAggregatePercentile != nil {
+		in, out := &in.SampleAggregatePercentile, &out.SampleAggregatePercentile
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.SampleWindow != nil {
+		in, out := &in.SampleWindow, &out.SampleWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MaxConcurrencyLimit != nil {
+		in, out := &in.MaxConcurrencyLimit, &out.MaxConcurrencyLimit
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinRTT != nil {
+		in, out := &in.MinRTT, &out.MinRTT
+		*out = new(AdaptiveConcurrencyMinRTT)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrency.
+func (in *AdaptiveConcurrency) DeepCopy() *AdaptiveConcurrency {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrency)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdaptiveConcurrencyMinRTT) DeepCopyInto(out *AdaptiveConcurrencyMinRTT) {
+	*out = *in
+	if in.Interval != nil {
+		in, out := &in.Interval, &out.Interval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.FixedValue != nil {
+		in, out := &in.FixedValue, &out.FixedValue
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.RequestCount != nil {
+		in, out := &in.RequestCount, &out.RequestCount
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinConcurrency != nil {
+		in, out := &in.MinConcurrency, &out.MinConcurrency
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Buffer != nil {
+		in, out := &in.Buffer, &out.Buffer
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdaptiveConcurrencyMinRTT.
+func (in *AdaptiveConcurrencyMinRTT) DeepCopy() *AdaptiveConcurrencyMinRTT {
+	if in == nil {
+		return nil
+	}
+	out := new(AdaptiveConcurrencyMinRTT)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AddHeader) DeepCopyInto(out *AddHeader) {
 	*out = *in
@@ -223,6 +350,56 @@ func (in *AddHeader) DeepCopy() *AddHeader {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdmissionControl) DeepCopyInto(out *AdmissionControl) {
+	*out = *in
+	if in.HTTPSuccessStatuses != nil {
+		in, out := &in.HTTPSuccessStatuses, &out.HTTPSuccessStatuses
+		*out = make([]StatusCodeRange, len(*in))
+		copy(*out, *in)
+	}
+	if in.GRPCSuccessStatuses != nil {
+		in, out := &in.GRPCSuccessStatuses, &out.GRPCSuccessStatuses
+		*out = make([]uint32, len(*in))
+		copy(*out, *in)
+	}
+	if in.SamplingWindow != nil {
+		in, out := &in.SamplingWindow, &out.SamplingWindow
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.SuccessRateThreshold != nil {
+		in, out := &in.SuccessRateThreshold, &out.SuccessRateThreshold
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Aggression != nil {
+		in, out := &in.Aggression, &out.Aggression
+		*out = new(float32)
+		**out = **in
+	}
+	if in.MinRPS != nil {
+		in, out := &in.MinRPS, &out.MinRPS
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MaxRejectionProbability != nil {
+		in, out := &in.MaxRejectionProbability, &out.MaxRejectionProbability
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdmissionControl.
+func (in *AdmissionControl) DeepCopy() *AdmissionControl {
+	if in == nil {
+		return nil
+	}
+	out := new(AdmissionControl)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Authorization) DeepCopyInto(out *Authorization) {
 	*out = *in
@@ -252,6 +429,11 @@ func (in *Authorization) DeepCopy() *Authorization {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AuthorizationRule) DeepCopyInto(out *AuthorizationRule) {
 	*out = *in
+	if in.Operation != nil {
+		in, out := &in.Operation, &out.Operation
+		*out = new(Operation)
+		(*in).DeepCopyInto(*out)
+	}
 	in.Principal.DeepCopyInto(&out.Principal)
 }
 
@@ -310,6 +492,26 @@ func (in *BackendConnection) DeepCopy() *BackendConnection {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BandwidthLimit) DeepCopyInto(out *BandwidthLimit) {
+	*out = *in
+	if in.FillInterval != nil {
+		in, out := &in.FillInterval, &out.FillInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BandwidthLimit.
+func (in *BandwidthLimit) DeepCopy() *BandwidthLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(BandwidthLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BasicAuth) DeepCopyInto(out *BasicAuth) {
 	*out = *in
@@ -391,6 +593,77 @@ func (in *CORS) DeepCopy() *CORS {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSRF) DeepCopyInto(out *CSRF) {
+	*out = *in
+	if in.AdditionalOrigins != nil {
+		in, out := &in.AdditionalOrigins, &out.AdditionalOrigins
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSRF.
+func (in *CSRF) DeepCopy() *CSRF {
+	if in == nil {
+		return nil
+	}
+	out := new(CSRF)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Cache) DeepCopyInto(out *Cache) {
+	*out = *in
+	if in.MaxSizeBytes != nil {
+		in, out := &in.MaxSizeBytes, &out.MaxSizeBytes
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.MaxBodyBytes != nil {
+		in, out := &in.MaxBodyBytes, &out.MaxBodyBytes
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.StatusCodes != nil {
+		in, out := &in.StatusCodes, &out.StatusCodes
+		*out = make([]HTTPStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.VaryHeaders != nil {
+		in, out := &in.VaryHeaders, &out.VaryHeaders
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TTL != nil {
+		in, out := &in.TTL, &out.TTL
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Cache.
+func (in *Cache) DeepCopy() *Cache {
+	if in == nil {
+		return nil
+	}
+	out := new(Cache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CircuitBreaker) DeepCopyInto(out *CircuitBreaker) {
 	*out = *in
@@ -431,6 +704,54 @@ func (in *CircuitBreaker) DeepCopy() *CircuitBreaker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClientCertificatePrincipal) DeepCopyInto(out *ClientCertificatePrincipal) {
+	*out = *in
+	if in.URISANs != nil {
+		in, out := &in.URISANs, &out.URISANs
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.DNSSANs != nil {
+		in, out := &in.DNSSANs, &out.DNSSANs
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Subjects != nil {
+		in, out := &in.Subjects, &out.Subjects
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClientCertificatePrincipal.
+func (in *ClientCertificatePrincipal) DeepCopy() *ClientCertificatePrincipal {
+	if in == nil {
+		return nil
+	}
+	out := new(ClientCertificatePrincipal)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClientConnection) DeepCopyInto(out *ClientConnection) {
 	*out = *in
@@ -506,6 +827,46 @@ func (in *ClientTimeout) DeepCopy() *ClientTimeout {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Compression) DeepCopyInto(out *Compression) {
+	*out = *in
+	if in.Gzip != nil {
+		in, out := &in.Gzip, &out.Gzip
+		*out = new(v1alpha1.GzipCompressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Brotli != nil {
+		in, out := &in.Brotli, &out.Brotli
+		*out = new(v1alpha1.BrotliCompressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Zstd != nil {
+		in, out := &in.Zstd, &out.Zstd
+		*out = new(v1alpha1.ZstdCompressor)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MinContentLength != nil {
+		in, out := &in.MinContentLength, &out.MinContentLength
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.ContentTypes != nil {
+		in, out := &in.ContentTypes, &out.ContentTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Compression.
+func (in *Compression) DeepCopy() *Compression {
+	if in == nil {
+		return nil
+	}
+	out := new(Compression)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConnectionLimit) DeepCopyInto(out *ConnectionLimit) {
 	*out = *in
@@ -622,6 +983,26 @@ func (in *CoreListenerDetails) DeepCopy() *CoreListenerDetails {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CredentialInjection) DeepCopyInto(out *CredentialInjection) {
+	*out = *in
+	if in.Credential != nil {
+		in, out := &in.Credential, &out.Credential
+		*out = make(PrivateBytes, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CredentialInjection.
+func (in *CredentialInjection) DeepCopy() *CredentialInjection {
+	if in == nil {
+		return nil
+	}
+	out := new(CredentialInjection)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CustomResponse) DeepCopyInto(out *CustomResponse) {
 	*out = *in
@@ -707,6 +1088,11 @@ func (in *DestinationEndpoint) DeepCopyInto(out *DestinationEndpoint) {
 		*out = new(string)
 		**out = **in
 	}
+	if in.Zone != nil {
+		in, out := &in.Zone, &out.Zone
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DestinationEndpoint.
@@ -831,6 +1217,16 @@ func (in *EnvoyExtensionFeatures) DeepCopyInto(out *EnvoyExtensionFeatures) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Luas != nil {
+		in, out := &in.Luas, &out.Luas
+		*out = make([]Lua, len(*in))
+		copy(*out, *in)
+	}
+	if in.GRPCJSONTranscoder != nil {
+		in, out := &in.GRPCJSONTranscoder, &out.GRPCJSONTranscoder
+		*out = new(GRPCJSONTranscoder)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvoyExtensionFeatures.
@@ -928,6 +1324,22 @@ func (in *ExtAuth) DeepCopyInto(out *ExtAuth) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.ContextExtensions != nil {
+		in, out := &in.ContextExtensions, &out.ContextExtensions
+		*out = make([]*ExtAuthContextExtension, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(ExtAuthContextExtension)
+				**out = **in
+			}
+		}
+	}
+	if in.DecisionCache != nil {
+		in, out := &in.DecisionCache, &out.DecisionCache
+		*out = new(ExtAuthDecisionCache)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuth.
@@ -940,6 +1352,42 @@ func (in *ExtAuth) DeepCopy() *ExtAuth {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthContextExtension) DeepCopyInto(out *ExtAuthContextExtension) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthContextExtension.
+func (in *ExtAuthContextExtension) DeepCopy() *ExtAuthContextExtension {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthContextExtension)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtAuthDecisionCache) DeepCopyInto(out *ExtAuthDecisionCache) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	out.TTL = in.TTL
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtAuthDecisionCache.
+func (in *ExtAuthDecisionCache) DeepCopy() *ExtAuthDecisionCache {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtAuthDecisionCache)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ExtProc) DeepCopyInto(out *ExtProc) {
 	*out = *in
@@ -1012,6 +1460,36 @@ func (in *ExtendedHTTPPathModifier) DeepCopy() *ExtendedHTTPPathModifier {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExtractFrom) DeepCopyInto(out *ExtractFrom) {
+	*out = *in
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Cookies != nil {
+		in, out := &in.Cookies, &out.Cookies
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExtractFrom.
+func (in *ExtractFrom) DeepCopy() *ExtractFrom {
+	if in == nil {
+		return nil
+	}
+	out := new(ExtractFrom)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FaultInjection) DeepCopyInto(out *FaultInjection) {
 	*out = *in
@@ -1128,6 +1606,36 @@ func (in *GRPCHealthChecker) DeepCopy() *GRPCHealthChecker {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GRPCJSONTranscoder) DeepCopyInto(out *GRPCJSONTranscoder) {
+	*out = *in
+	if in.ProtoDescriptorBin != nil {
+		in, out := &in.ProtoDescriptorBin, &out.ProtoDescriptorBin
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+	if in.Services != nil {
+		in, out := &in.Services, &out.Services
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PrintOptions != nil {
+		in, out := &in.PrintOptions, &out.PrintOptions
+		*out = new(v1alpha1.GRPCJSONTranscoderPrintOptions)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GRPCJSONTranscoder.
+func (in *GRPCJSONTranscoder) DeepCopy() *GRPCJSONTranscoder {
+	if in == nil {
+		return nil
+	}
+	out := new(GRPCJSONTranscoder)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *GlobalRateLimit) DeepCopyInto(out *GlobalRateLimit) {
 	*out = *in
@@ -1154,6 +1662,47 @@ func (in *GlobalRateLimit) DeepCopy() *GlobalRateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACAuth) DeepCopyInto(out *HMACAuth) {
+	*out = *in
+	if in.Secret != nil {
+		in, out := &in.Secret, &out.Secret
+		*out = make(PrivateBytes, len(*in))
+		copy(*out, *in)
+	}
+	if in.Timestamp != nil {
+		in, out := &in.Timestamp, &out.Timestamp
+		*out = new(HMACTimestamp)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACAuth.
+func (in *HMACAuth) DeepCopy() *HMACAuth {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACAuth)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *HMACTimestamp) DeepCopyInto(out *HMACTimestamp) {
+	*out = *in
+	out.MaxSkew = in.MaxSkew
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new HMACTimestamp.
+func (in *HMACTimestamp) DeepCopy() *HMACTimestamp {
+	if in == nil {
+		return nil
+	}
+	out := new(HMACTimestamp)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *HTTP10Settings) DeepCopyInto(out *HTTP10Settings) {
 	*out = *in
@@ -1509,11 +2058,11 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 	}
 	if in.Mirrors != nil {
 		in, out := &in.Mirrors, &out.Mirrors
-		*out = make([]*RouteDestination, len(*in))
+		*out = make([]*MirrorPolicy, len(*in))
 		for i := range *in {
 			if (*in)[i] != nil {
 				in, out := &(*in)[i], &(*out)[i]
-				*out = new(RouteDestination)
+				*out = new(MirrorPolicy)
 				(*in).DeepCopyInto(*out)
 			}
 		}
@@ -1528,6 +2077,11 @@ func (in *HTTPRoute) DeepCopyInto(out *HTTPRoute) {
 		*out = new(URLRewrite)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CredentialInjection != nil {
+		in, out := &in.CredentialInjection, &out.CredentialInjection
+		*out = new(CredentialInjection)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtensionRefs != nil {
 		in, out := &in.ExtensionRefs, &out.ExtensionRefs
 		*out = make([]*UnstructuredRef, len(*in))
@@ -1897,6 +2451,31 @@ func (in *JWT) DeepCopy() *JWT {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *JWTClaimMatch) DeepCopyInto(out *JWTClaimMatch) {
+	*out = *in
+	if in.Claim != nil {
+		in, out := &in.Claim, &out.Claim
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Value != nil {
+		in, out := &in.Value, &out.Value
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new JWTClaimMatch.
+func (in *JWTClaimMatch) DeepCopy() *JWTClaimMatch {
+	if in == nil {
+		return nil
+	}
+	out := new(JWTClaimMatch)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *LeastRequest) DeepCopyInto(out *LeastRequest) {
 	*out = *in
@@ -1955,6 +2534,11 @@ func (in *LoadBalancer) DeepCopyInto(out *LoadBalancer) {
 		*out = new(ConsistentHash)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ZoneAware != nil {
+		in, out := &in.ZoneAware, &out.ZoneAware
+		*out = new(ZoneAware)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancer.
@@ -1962,7 +2546,33 @@ func (in *LoadBalancer) DeepCopy() *LoadBalancer {
 	if in == nil {
 		return nil
 	}
-	out := new(LoadBalancer)
+	out := new(LoadBalancer)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LocalCluster) DeepCopyInto(out *LocalCluster) {
+	*out = *in
+	if in.Endpoints != nil {
+		in, out := &in.Endpoints, &out.Endpoints
+		*out = make([]*DestinationEndpoint, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(DestinationEndpoint)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LocalCluster.
+func (in *LocalCluster) DeepCopy() *LocalCluster {
+	if in == nil {
+		return nil
+	}
+	out := new(LocalCluster)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1994,6 +2604,21 @@ func (in *LocalRateLimit) DeepCopy() *LocalRateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Lua) DeepCopyInto(out *Lua) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Lua.
+func (in *Lua) DeepCopy() *Lua {
+	if in == nil {
+		return nil
+	}
+	out := new(Lua)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Metrics) DeepCopyInto(out *Metrics) {
 	*out = *in
@@ -2009,6 +2634,71 @@ func (in *Metrics) DeepCopy() *Metrics {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MirrorPolicy) DeepCopyInto(out *MirrorPolicy) {
+	*out = *in
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(RouteDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Percentage != nil {
+		in, out := &in.Percentage, &out.Percentage
+		*out = new(float32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MirrorPolicy.
+func (in *MirrorPolicy) DeepCopy() *MirrorPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MirrorPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OAuth2ClientCredentials) DeepCopyInto(out *OAuth2ClientCredentials) {
+	*out = *in
+	if in.Destination != nil {
+		in, out := &in.Destination, &out.Destination
+		*out = new(RouteDestination)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Traffic != nil {
+		in, out := &in.Traffic, &out.Traffic
+		*out = new(TrafficFeatures)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClientSecret != nil {
+		in, out := &in.ClientSecret, &out.ClientSecret
+		*out = make(PrivateBytes, len(*in))
+		copy(*out, *in)
+	}
+	if in.Scopes != nil {
+		in, out := &in.Scopes, &out.Scopes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.TokenFetchRetryInterval != nil {
+		in, out := &in.TokenFetchRetryInterval, &out.TokenFetchRetryInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OAuth2ClientCredentials.
+func (in *OAuth2ClientCredentials) DeepCopy() *OAuth2ClientCredentials {
+	if in == nil {
+		return nil
+	}
+	out := new(OAuth2ClientCredentials)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OIDC) DeepCopyInto(out *OIDC) {
 	*out = *in
@@ -2140,6 +2830,70 @@ func (in *OpenTelemetryAccessLog) DeepCopy() *OpenTelemetryAccessLog {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Operation) DeepCopyInto(out *Operation) {
+	*out = *in
+	if in.Methods != nil {
+		in, out := &in.Methods, &out.Methods
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Paths != nil {
+		in, out := &in.Paths, &out.Paths
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Headers != nil {
+		in, out := &in.Headers, &out.Headers
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Hosts != nil {
+		in, out := &in.Hosts, &out.Hosts
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.ServerNames != nil {
+		in, out := &in.ServerNames, &out.ServerNames
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Operation.
+func (in *Operation) DeepCopy() *Operation {
+	if in == nil {
+		return nil
+	}
+	out := new(Operation)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OutlierDetection) DeepCopyInto(out *OutlierDetection) {
 	*out = *in
@@ -2230,6 +2984,26 @@ func (in *PerRetryPolicy) DeepCopy() *PerRetryPolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreferLocalZone) DeepCopyInto(out *PreferLocalZone) {
+	*out = *in
+	if in.MinEndpointsThreshold != nil {
+		in, out := &in.MinEndpointsThreshold, &out.MinEndpointsThreshold
+		*out = new(uint64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreferLocalZone.
+func (in *PreferLocalZone) DeepCopy() *PreferLocalZone {
+	if in == nil {
+		return nil
+	}
+	out := new(PreferLocalZone)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Principal) DeepCopyInto(out *Principal) {
 	*out = *in
@@ -2249,6 +3023,11 @@ func (in *Principal) DeepCopyInto(out *Principal) {
 		*out = new(v1alpha1.JWTPrincipal)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(ClientCertificatePrincipal)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Principal.
@@ -2387,6 +3166,31 @@ func (in *RateLimit) DeepCopy() *RateLimit {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RateLimitCost) DeepCopyInto(out *RateLimitCost) {
+	*out = *in
+	if in.Number != nil {
+		in, out := &in.Number, &out.Number
+		*out = new(uint64)
+		**out = **in
+	}
+	if in.Format != nil {
+		in, out := &in.Format, &out.Format
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitCost.
+func (in *RateLimitCost) DeepCopy() *RateLimitCost {
+	if in == nil {
+		return nil
+	}
+	out := new(RateLimitCost)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RateLimitRule) DeepCopyInto(out *RateLimitRule) {
 	*out = *in
@@ -2406,7 +3210,49 @@ func (in *RateLimitRule) DeepCopyInto(out *RateLimitRule) {
 		*out = new(CIDRMatch)
 		**out = **in
 	}
+	if in.MethodMatches != nil {
+		in, out := &in.MethodMatches, &out.MethodMatches
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.PathMatch != nil {
+		in, out := &in.PathMatch, &out.PathMatch
+		*out = new(StringMatch)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.QueryParamMatches != nil {
+		in, out := &in.QueryParamMatches, &out.QueryParamMatches
+		*out = make([]*StringMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(StringMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.JWTClaimMatches != nil {
+		in, out := &in.JWTClaimMatches, &out.JWTClaimMatches
+		*out = make([]*JWTClaimMatch, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(JWTClaimMatch)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	out.Limit = in.Limit
+	if in.RequestCost != nil {
+		in, out := &in.RequestCost, &out.RequestCost
+		*out = new(RateLimitCost)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResponseCost != nil {
+		in, out := &in.ResponseCost, &out.ResponseCost
+		*out = new(RateLimitCost)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RateLimitRule.
@@ -2489,6 +3335,21 @@ func (in *RegexMatchReplace) DeepCopy() *RegexMatchReplace {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RequestBuffer) DeepCopyInto(out *RequestBuffer) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RequestBuffer.
+func (in *RequestBuffer) DeepCopy() *RequestBuffer {
+	if in == nil {
+		return nil
+	}
+	out := new(RequestBuffer)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceMetadata) DeepCopyInto(out *ResourceMetadata) {
 	*out = *in
@@ -2568,6 +3429,11 @@ func (in *Retry) DeepCopyInto(out *Retry) {
 		*out = new(PerRetryPolicy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Budget != nil {
+		in, out := &in.Budget, &out.Budget
+		*out = new(RetryBudget)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Retry.
@@ -2580,6 +3446,31 @@ func (in *Retry) DeepCopy() *Retry {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetryBudget) DeepCopyInto(out *RetryBudget) {
+	*out = *in
+	if in.Percent != nil {
+		in, out := &in.Percent, &out.Percent
+		*out = new(uint32)
+		**out = **in
+	}
+	if in.MinConcurrency != nil {
+		in, out := &in.MinConcurrency, &out.MinConcurrency
+		*out = new(uint32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetryBudget.
+func (in *RetryBudget) DeepCopy() *RetryBudget {
+	if in == nil {
+		return nil
+	}
+	out := new(RetryBudget)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RetryOn) DeepCopyInto(out *RetryOn) {
 	*out = *in
@@ -2659,6 +3550,11 @@ func (in *SecurityFeatures) DeepCopyInto(out *SecurityFeatures) {
 		*out = new(CORS)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CSRF != nil {
+		in, out := &in.CSRF, &out.CSRF
+		*out = new(CSRF)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.JWT != nil {
 		in, out := &in.JWT, &out.JWT
 		*out = new(JWT)
@@ -2674,6 +3570,16 @@ func (in *SecurityFeatures) DeepCopyInto(out *SecurityFeatures) {
 		*out = new(BasicAuth)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.APIKeyAuth != nil {
+		in, out := &in.APIKeyAuth, &out.APIKeyAuth
+		*out = new(APIKeyAuth)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.HMACAuth != nil {
+		in, out := &in.HMACAuth, &out.HMACAuth
+		*out = new(HMACAuth)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.ExtAuth != nil {
 		in, out := &in.ExtAuth, &out.ExtAuth
 		*out = new(ExtAuth)
@@ -3340,6 +4246,47 @@ func (in *TrafficFeatures) DeepCopyInto(out *TrafficFeatures) {
 		*out = new(ResponseOverride)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.OAuth2ClientCredentials != nil {
+		in, out := &in.OAuth2ClientCredentials, &out.OAuth2ClientCredentials
+		*out = new(OAuth2ClientCredentials)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdaptiveConcurrency != nil {
+		in, out := &in.AdaptiveConcurrency, &out.AdaptiveConcurrency
+		*out = new(AdaptiveConcurrency)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AdmissionControl != nil {
+		in, out := &in.AdmissionControl, &out.AdmissionControl
+		*out = new(AdmissionControl)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Compression != nil {
+		in, out := &in.Compression, &out.Compression
+		*out = make([]*Compression, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Compression)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
+	if in.Cache != nil {
+		in, out := &in.Cache, &out.Cache
+		*out = new(Cache)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.RequestBuffer != nil {
+		in, out := &in.RequestBuffer, &out.RequestBuffer
+		*out = new(RequestBuffer)
+		**out = **in
+	}
+	if in.BandwidthLimit != nil {
+		in, out := &in.BandwidthLimit, &out.BandwidthLimit
+		*out = new(BandwidthLimit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TrafficFeatures.
@@ -3571,6 +4518,11 @@ func (in *Xds) DeepCopyInto(out *Xds) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.LocalCluster != nil {
+		in, out := &in.LocalCluster, &out.LocalCluster
+		*out = new(LocalCluster)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Xds.
@@ -3582,3 +4534,28 @@ func (in *Xds) DeepCopy() *Xds {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ZoneAware) DeepCopyInto(out *ZoneAware) {
+	*out = *in
+	if in.PreferLocal != nil {
+		in, out := &in.PreferLocal, &out.PreferLocal
+		*out = new(PreferLocalZone)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.WeightedZones != nil {
+		in, out := &in.WeightedZones, &out.WeightedZones
+		*out = make([]WeightedZone, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ZoneAware.
+func (in *ZoneAware) DeepCopy() *ZoneAware {
+	if in == nil {
+		return nil
+	}
+	out := new(ZoneAware)
+	in.DeepCopyInto(out)
+	return out
+}
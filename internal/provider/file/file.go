@@ -33,6 +33,9 @@ type Provider struct {
 	watcher        filewatcher.FileWatcher
 	resourcesStore *resourcesStore
 
+	// cidrListFiles holds the paths of the watched CIDR list files.
+	cidrListFiles sets.Set[string]
+
 	// ready indicates whether the provider can start watching filesystem events.
 	ready atomic.Bool
 }
@@ -49,6 +52,7 @@ func New(svr *config.Server, resources *message.ProviderResources) (*Provider, e
 		logger:         logger,
 		watcher:        filewatcher.NewWatcher(),
 		resourcesStore: newResourcesStore(svr.EnvoyGateway.Gateway.ControllerName, resources, logger),
+		cidrListFiles:  sets.New[string](),
 	}, nil
 }
 
@@ -85,14 +89,14 @@ func (p *Provider) Start(ctx context.Context) error {
 			p.logger.Info("Watching path added", "path", path)
 		}
 
-		ch := p.watcher.Events(path)
-		go func(c chan fsnotify.Event) {
-			for msg := range c {
-				aggCh <- msg
-			}
-		}(ch)
+		go forwardEvents(ctx, p.watcher.Events(path), aggCh)
 	}
 
+	// Watch the CIDR list files referenced by the resources, and reload all the
+	// resources when one of them changes.
+	cidrListCh := make(chan fsnotify.Event)
+	p.watchCIDRListFiles(ctx, cidrListCh)
+
 	p.ready.Store(true)
 	curDirs, curFiles := initDirs.Clone(), initFiles.Clone()
 	initFilesParent := path.GetParentDirs(initFiles.UnsortedList())
@@ -100,6 +104,18 @@ func (p *Provider) Start(ctx context.Context) error {
 		select {
 		case <-ctx.Done():
 			return nil
+		case event := <-cidrListCh:
+			if event.Has(fsnotify.Chmod) {
+				continue
+			}
+			p.logger.Info("CIDR list file changed", "op", event.Op, "name", event.Name)
+			// The watches are added again when a file is removed or renamed, so that
+			// they follow the files that replace them.
+			if event.Has(fsnotify.Remove) || event.Has(fsnotify.Rename) {
+				p.unwatchCIDRListFiles()
+			}
+			p.resourcesStore.HandleEvent(curFiles.UnsortedList(), curDirs.UnsortedList())
+			p.watchCIDRListFiles(ctx, cidrListCh)
 		case event := <-aggCh:
 			// Ignore the irrelevant event.
 			if event.Has(fsnotify.Chmod) {
@@ -156,6 +172,65 @@ func (p *Provider) Start(ctx context.Context) error {
 
 		handle:
 			p.resourcesStore.HandleEvent(curFiles.UnsortedList(), curDirs.UnsortedList())
+			p.watchCIDRListFiles(ctx, cidrListCh)
+		}
+	}
+}
+
+// watchCIDRListFiles updates the watched CIDR list files to the ones referenced by
+// the last loaded resources, and forwards their events to the provided channel.
+// The watcher watches the parent directory of each file, so the replacement of a
+// file by a rename or a symlink swap is reported as a change of the file.
+// A file that can't be watched yet, e.g. because its directory doesn't exist, is
+// retried on the next reload.
+func (p *Provider) watchCIDRListFiles(ctx context.Context, ch chan fsnotify.Event) {
+	files := p.resourcesStore.cidrListFiles
+
+	for path := range p.cidrListFiles.Difference(files) {
+		if err := p.watcher.Remove(path); err != nil {
+			p.logger.Error(err, "failed to remove watch", "path", path)
+		}
+		p.cidrListFiles.Delete(path)
+	}
+
+	for path := range files.Difference(p.cidrListFiles) {
+		if err := p.watcher.Add(path); err != nil {
+			p.logger.Error(err, "failed to add watch", "path", path)
+			continue
+		}
+		p.logger.Info("Watching CIDR list file added", "path", path)
+		p.cidrListFiles.Insert(path)
+
+		go forwardEvents(ctx, p.watcher.Events(path), ch)
+	}
+}
+
+// unwatchCIDRListFiles removes the watches of all the CIDR list files.
+func (p *Provider) unwatchCIDRListFiles() {
+	for path := range p.cidrListFiles {
+		if err := p.watcher.Remove(path); err != nil {
+			p.logger.Error(err, "failed to remove watch", "path", path)
+		}
+	}
+	p.cidrListFiles.Clear()
+}
+
+// forwardEvents forwards the events of a watched path to the provided channel,
+// until the watch is removed or the context is done.
+func forwardEvents(ctx context.Context, from, to chan fsnotify.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case event, ok := <-from:
+			if !ok {
+				return
+			}
+			select {
+			case to <- event:
+			case <-ctx.Done():
+				return
+			}
 		}
 	}
 }
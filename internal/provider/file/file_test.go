@@ -7,6 +7,7 @@ package file
 
 import (
 	"context"
+	"fmt"
 	"html/template"
 	"io"
 	"net/http"
@@ -174,12 +175,107 @@ func TestFileProvider(t *testing.T) {
 		}, resourcesUpdateTimeout, resourcesUpdateTick)
 	})
 
+	t.Run("replace a CIDR list file by renames", func(t *testing.T) {
+		cidrListDir := t.TempDir()
+		cidrListFile := filepath.Join(cidrListDir, "cidrs.txt")
+		require.NoError(t, os.WriteFile(cidrListFile, []byte("10.0.0.0/8\n"), 0o600))
+
+		newFilePath := filepath.Join(watchDirPath, "test.yaml")
+		require.NoError(t, os.WriteFile(newFilePath, []byte(fmt.Sprintf(cidrListResources, cidrListFile)), 0o600))
+		t.Cleanup(func() {
+			_ = os.Remove(newFilePath)
+		})
+
+		clientCIDRs := func() []egv1a1.CIDR {
+			resources := pResources.GetResourcesByGatewayClass("eg")
+			if resources == nil || len(resources.SecurityPolicies) == 0 {
+				return nil
+			}
+			return resources.SecurityPolicies[0].Spec.Authorization.Rules[0].Principal.ClientCIDRs
+		}
+		requireClientCIDRs := func(cidrs ...egv1a1.CIDR) {
+			require.Eventually(t, func() bool {
+				return cmp.Equal(cidrs, clientCIDRs(), cmpopts.EquateEmpty())
+			}, resourcesUpdateTimeout, resourcesUpdateTick)
+		}
+		// replace writes the CIDR list to a temporary file, and renames it over the
+		// watched file, like the editors and the atomic writers do.
+		replace := func(cidrs string) {
+			tmpFile := filepath.Join(cidrListDir, "cidrs.txt.tmp")
+			require.NoError(t, os.WriteFile(tmpFile, []byte(cidrs), 0o600))
+			require.NoError(t, os.Rename(tmpFile, cidrListFile))
+		}
+
+		requireClientCIDRs("10.0.0.0/8")
+
+		// The watch follows the file after it has been replaced, so it's replaced twice.
+		replace("192.168.0.0/16\n")
+		requireClientCIDRs("192.168.0.0/16")
+		replace("172.16.0.0/12\n")
+		requireClientCIDRs("172.16.0.0/12")
+
+		// Rename the file away, then rename it back.
+		renamedFile := filepath.Join(cidrListDir, "cidrs.txt.bak")
+		require.NoError(t, os.Rename(cidrListFile, renamedFile))
+		requireClientCIDRs()
+		require.NoError(t, os.Rename(renamedFile, cidrListFile))
+		requireClientCIDRs("172.16.0.0/12")
+
+		// Swap the symlinks like the Kubernetes ConfigMap volumes do:
+		// cidrs.txt -> ..data/cidrs.txt, and ..data -> a versioned directory.
+		swap := func(version, cidrs string) {
+			versionDir := filepath.Join(cidrListDir, version)
+			require.NoError(t, os.Mkdir(versionDir, 0o700))
+			require.NoError(t, os.WriteFile(filepath.Join(versionDir, "cidrs.txt"), []byte(cidrs), 0o600))
+			tmpLink := filepath.Join(cidrListDir, "..data_tmp")
+			require.NoError(t, os.Symlink(version, tmpLink))
+			require.NoError(t, os.Rename(tmpLink, filepath.Join(cidrListDir, "..data")))
+		}
+		swap("..v1", "10.0.0.0/8\n")
+		tmpLink := filepath.Join(cidrListDir, "cidrs.txt.tmp")
+		require.NoError(t, os.Symlink(filepath.Join("..data", "cidrs.txt"), tmpLink))
+		require.NoError(t, os.Rename(tmpLink, cidrListFile))
+		requireClientCIDRs("10.0.0.0/8")
+		swap("..v2", "192.168.0.0/16\n")
+		requireClientCIDRs("192.168.0.0/16")
+	})
+
 	t.Cleanup(func() {
 		_ = os.RemoveAll(watchFileBase)
 		_ = os.RemoveAll(watchDirPath)
 	})
 }
 
+// cidrListResources holds a SecurityPolicy that reads its client CIDRs from the
+// CIDR list file at the path given as format argument.
+const cidrListResources = `apiVersion: gateway.networking.k8s.io/v1
+kind: GatewayClass
+metadata:
+  name: eg
+spec:
+  controllerName: gateway.envoyproxy.io/gatewayclass-controller
+---
+apiVersion: gateway.envoyproxy.io/v1alpha1
+kind: SecurityPolicy
+metadata:
+  name: authorization-client-cidrs-from
+  namespace: default
+spec:
+  targetRefs:
+  - group: gateway.networking.k8s.io
+    kind: Gateway
+    name: eg
+  authorization:
+    defaultAction: Allow
+    rules:
+    - action: Deny
+      principal:
+        clientCIDRsFrom:
+        - type: File
+          file:
+            path: %s
+`
+
 func writeResourcesFile(t *testing.T, tmpl, dst string, params *resourcesParam) {
 	dstFile, err := os.Create(dst)
 	require.NoError(t, err)
@@ -11,7 +11,12 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+	netutils "github.com/envoyproxy/gateway/internal/utils/net"
 )
 
 // loadFromFilesAndDirs loads resources from specific files and directories.
@@ -78,3 +83,52 @@ func loadFromDir(path string) ([]*resource.Resources, error) {
 
 	return rs, nil
 }
+
+// resolveCIDRListFiles inlines the content of the CIDR list files referenced by the
+// authorization rules of the SecurityPolicies into their client CIDRs, and returns
+// the paths of all the referenced files, so that they can be watched for changes.
+//
+// The sources of the files that can't be read are left in place, so that the
+// translator reports them in the status of the SecurityPolicies.
+func resolveCIDRListFiles(rs []*resource.Resources, logger logr.Logger) sets.Set[string] {
+	files := sets.New[string]()
+
+	for _, r := range rs {
+		for _, policy := range r.SecurityPolicies {
+			if policy.Spec.Authorization == nil {
+				continue
+			}
+
+			for i := range policy.Spec.Authorization.Rules {
+				principal := policy.Spec.Authorization.Rules[i].Principal
+				if principal == nil || len(principal.ClientCIDRsFrom) == 0 {
+					continue
+				}
+
+				var sources []egv1a1.CIDRListSource
+				for _, source := range principal.ClientCIDRsFrom {
+					if source.Type != egv1a1.CIDRListSourceTypeFile || source.File == nil {
+						sources = append(sources, source)
+						continue
+					}
+
+					files.Insert(source.File.Path)
+					data, err := os.ReadFile(source.File.Path)
+					if err != nil {
+						logger.Error(err, "failed to read CIDR list file",
+							"path", source.File.Path, "policy", policy.Namespace+"/"+policy.Name)
+						sources = append(sources, source)
+						continue
+					}
+
+					for _, cidr := range netutils.SplitCIDRList(string(data)) {
+						principal.ClientCIDRs = append(principal.ClientCIDRs, egv1a1.CIDR(cidr))
+					}
+				}
+				principal.ClientCIDRsFrom = sources
+			}
+		}
+	}
+
+	return files
+}
@@ -0,0 +1,75 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package file
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/go-logr/logr"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+)
+
+func TestResolveCIDRListFiles(t *testing.T) {
+	dir := t.TempDir()
+	cidrListFile := filepath.Join(dir, "blocklist.txt")
+	missingFile := filepath.Join(dir, "missing.txt")
+	require.NoError(t, os.WriteFile(cidrListFile, []byte("# blocklist\n10.0.0.0/8\n\n  192.168.0.0/16  \n"), 0o600))
+
+	configMapSource := egv1a1.CIDRListSource{
+		Type: egv1a1.CIDRListSourceTypeConfigMap,
+		ConfigMap: &egv1a1.CIDRListConfigMapReference{
+			Name: gwapiv1.ObjectName("blocklist"),
+			Key:  "cidrs",
+		},
+	}
+	missingFileSource := egv1a1.CIDRListSource{
+		Type: egv1a1.CIDRListSourceTypeFile,
+		File: &egv1a1.CIDRListFile{Path: missingFile},
+	}
+
+	policy := &egv1a1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "policy",
+		},
+		Spec: egv1a1.SecurityPolicySpec{
+			Authorization: &egv1a1.Authorization{
+				Rules: []egv1a1.AuthorizationRule{
+					{
+						Action: egv1a1.AuthorizationActionDeny,
+						Principal: &egv1a1.Principal{
+							ClientCIDRs: []egv1a1.CIDR{"172.16.0.0/12"},
+							ClientCIDRsFrom: []egv1a1.CIDRListSource{
+								{
+									Type: egv1a1.CIDRListSourceTypeFile,
+									File: &egv1a1.CIDRListFile{Path: cidrListFile},
+								},
+								configMapSource,
+								missingFileSource,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	files := resolveCIDRListFiles([]*resource.Resources{
+		{SecurityPolicies: []*egv1a1.SecurityPolicy{policy}},
+	}, logr.Discard())
+
+	require.ElementsMatch(t, []string{cidrListFile, missingFile}, files.UnsortedList())
+	principal := policy.Spec.Authorization.Rules[0].Principal
+	require.Equal(t, []egv1a1.CIDR{"172.16.0.0/12", "10.0.0.0/8", "192.168.0.0/16"}, principal.ClientCIDRs)
+	require.Equal(t, []egv1a1.CIDRListSource{configMapSource, missingFileSource}, principal.ClientCIDRsFrom)
+}
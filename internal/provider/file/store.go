@@ -7,6 +7,7 @@ package file
 
 import (
 	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
 	"github.com/envoyproxy/gateway/internal/message"
@@ -16,6 +17,10 @@ type resourcesStore struct {
 	name      string
 	resources *message.ProviderResources
 
+	// cidrListFiles holds the paths of the CIDR list files referenced by the
+	// last loaded resources.
+	cidrListFiles sets.Set[string]
+
 	logger logr.Logger
 }
 
@@ -45,6 +50,7 @@ func (r *resourcesStore) LoadAndStore(files, dirs []string) error {
 	if err != nil {
 		return err
 	}
+	r.cidrListFiles = resolveCIDRListFiles(resources, r.logger)
 
 	// TODO(sh2): For now, we assume that one file only contains one GatewayClass and all its other
 	// related resources, like Gateway, HTTPRoute, etc. If we managed to extend Resources structure,
@@ -479,9 +479,42 @@ func (r *gatewayAPIReconciler) processBackendRefs(ctx context.Context, gwcResour
 	}
 }
 
+// processEnvoyProxyEndpointSlices adds the EndpointSlices of the Envoy service of the
+// Gateway to the resourceTree when the Gateway uses the zone-aware routing. They hold
+// the zones of the Envoy proxies.
+func (r *gatewayAPIReconciler) processEnvoyProxyEndpointSlices(ctx context.Context, gtw *gwapiv1.Gateway,
+	resourceMap *resourceMappings, resourceTree *resource.Resources,
+) error {
+	if !r.btpCRDExists || !r.isZoneAwareRoutingUsed(ctx, gtw) {
+		return nil
+	}
+
+	endpointSliceList := new(discoveryv1.EndpointSliceList)
+	if err := r.client.List(ctx, endpointSliceList,
+		client.MatchingLabels(gatewayapi.OwnerLabels(gtw, r.mergeGateways.Has(string(gtw.Spec.GatewayClassName)))),
+		client.InNamespace(r.namespace),
+	); err != nil {
+		return err
+	}
+
+	for _, endpointSlice := range endpointSliceList.Items {
+		key := utils.NamespacedName(&endpointSlice).String()
+		if !resourceMap.allAssociatedEndpointSlices.Has(key) {
+			resourceMap.allAssociatedEndpointSlices.Insert(key)
+			r.log.Info("added EndpointSlice to resource tree",
+				"namespace", endpointSlice.Namespace,
+				"name", endpointSlice.Name)
+			resourceTree.EndpointSlices = append(resourceTree.EndpointSlices, &endpointSlice)
+		}
+	}
+	return nil
+}
+
 // processSecurityPolicyObjectRefs adds the referenced resources in SecurityPolicies
 // to the resourceTree
-// - Secrets for OIDC and BasicAuth
+// - Secrets for OIDC, BasicAuth, APIKeyAuth and HMACAuth
+// - ConfigMaps and Secrets for JWT local JWKS
+// - ConfigMaps for Authorization client CIDR lists
 // - BackendRefs for ExAuth
 func (r *gatewayAPIReconciler) processSecurityPolicyObjectRefs(
 	ctx context.Context, resourceTree *resource.Resources, resourceMap *resourceMappings,
@@ -529,6 +562,104 @@ func (r *gatewayAPIReconciler) processSecurityPolicyObjectRefs(
 			}
 		}
 
+		// Add the referenced Secrets in APIKeyAuth to the resourceTree
+		apiKeyAuth := policy.Spec.APIKeyAuth
+		if apiKeyAuth != nil {
+			for _, ref := range apiKeyAuth.CredentialRefs {
+				if err := r.processSecretRef(
+					ctx,
+					resourceMap,
+					resourceTree,
+					resource.KindSecurityPolicy,
+					policy.Namespace,
+					policy.Name,
+					ref); err != nil {
+					r.log.Error(err,
+						"failed to process APIKeyAuth SecretRef for SecurityPolicy",
+						"policy", policy, "secretRef", ref)
+				}
+			}
+		}
+
+		// Add the referenced Secret in HMACAuth to the resourceTree
+		hmacAuth := policy.Spec.HMACAuth
+		if hmacAuth != nil {
+			if err := r.processSecretRef(
+				ctx,
+				resourceMap,
+				resourceTree,
+				resource.KindSecurityPolicy,
+				policy.Namespace,
+				policy.Name,
+				hmacAuth.SecretRef); err != nil {
+				r.log.Error(err,
+					"failed to process HMACAuth SecretRef for SecurityPolicy",
+					"policy", policy, "secretRef", hmacAuth.SecretRef)
+			}
+		}
+
+		// Add the referenced ConfigMaps and Secrets in JWT local JWKS to the resourceTree
+		if policy.Spec.JWT != nil {
+			for _, provider := range policy.Spec.JWT.Providers {
+				if provider.LocalJWKS == nil || provider.LocalJWKS.ValueRef == nil {
+					continue
+				}
+
+				valueRef := provider.LocalJWKS.ValueRef
+				ref := gwapiv1.SecretObjectReference{
+					Group: &valueRef.Group,
+					Kind:  &valueRef.Kind,
+					Name:  valueRef.Name,
+				}
+
+				var err error
+				switch string(valueRef.Kind) {
+				case resource.KindConfigMap:
+					err = r.processConfigMapRef(
+						ctx,
+						resourceMap,
+						resourceTree,
+						resource.KindSecurityPolicy,
+						policy.Namespace,
+						policy.Name,
+						ref)
+				case resource.KindSecret:
+					err = r.processSecretRef(
+						ctx,
+						resourceMap,
+						resourceTree,
+						resource.KindSecurityPolicy,
+						policy.Namespace,
+						policy.Name,
+						ref)
+				}
+				if err != nil {
+					r.log.Error(err,
+						"failed to process JWT LocalJWKS ValueRef for SecurityPolicy",
+						"policy", policy, "valueRef", valueRef)
+				}
+			}
+		}
+
+		// Add the referenced ConfigMaps in Authorization client CIDR lists to the resourceTree
+		for _, name := range cidrListConfigMapReferences(policy) {
+			if err := r.processConfigMapRef(
+				ctx,
+				resourceMap,
+				resourceTree,
+				resource.KindSecurityPolicy,
+				policy.Namespace,
+				policy.Name,
+				gwapiv1.SecretObjectReference{
+					Kind: ptr.To[gwapiv1.Kind](resource.KindConfigMap),
+					Name: name,
+				}); err != nil {
+				r.log.Error(err,
+					"failed to process Authorization client CIDR list ConfigMap for SecurityPolicy",
+					"policy", policy, "configMap", name)
+			}
+		}
+
 		// Add the referenced BackendRefs and ReferenceGrants in ExtAuth to Maps for later processing
 		extAuth := policy.Spec.ExtAuth
 		if extAuth != nil {
@@ -825,6 +956,78 @@ func (r *gatewayAPIReconciler) processBtpConfigMapRefs(
 	}
 }
 
+// processBtpObjectRefs adds the referenced Secrets, BackendRefs and ReferenceGrants
+// in BackendTrafficPolicies to the resourceTree and resourceMap.
+func (r *gatewayAPIReconciler) processBtpObjectRefs(
+	ctx context.Context, resourceTree *resource.Resources, resourceMap *resourceMappings,
+) {
+	// we don't return errors from this method, because we want to continue reconciling
+	// the rest of the BackendTrafficPolicies despite that one reference is invalid.
+	//
+	// This BackendTrafficPolicy will be marked as invalid in its status when translating
+	// to IR because the referenced object can't be found.
+	for _, policy := range resourceTree.BackendTrafficPolicies {
+		cc := policy.Spec.OAuth2ClientCredentials
+		if cc == nil {
+			continue
+		}
+
+		// Add the referenced Secret in OAuth2ClientCredentials to the resourceTree
+		if err := r.processSecretRef(
+			ctx,
+			resourceMap,
+			resourceTree,
+			resource.KindBackendTrafficPolicy,
+			policy.Namespace,
+			policy.Name,
+			cc.ClientSecret); err != nil {
+			r.log.Error(err,
+				"failed to process OAuth2ClientCredentials SecretRef for BackendTrafficPolicy",
+				"policy", policy, "secretRef", cc.ClientSecret)
+		}
+
+		// Add the referenced BackendRefs and ReferenceGrants of the token endpoint to Maps for later processing
+		for _, ref := range cc.BackendRefs {
+			backendRef := ref.BackendObjectReference
+			backendNamespace := gatewayapi.NamespaceDerefOr(backendRef.Namespace, policy.Namespace)
+			resourceMap.allAssociatedBackendRefs.Insert(gwapiv1.BackendObjectReference{
+				Group:     backendRef.Group,
+				Kind:      backendRef.Kind,
+				Namespace: gatewayapi.NamespacePtr(backendNamespace),
+				Name:      backendRef.Name,
+			})
+
+			if backendNamespace != policy.Namespace {
+				from := ObjectKindNamespacedName{
+					kind:      resource.KindBackendTrafficPolicy,
+					namespace: policy.Namespace,
+					name:      policy.Name,
+				}
+				to := ObjectKindNamespacedName{
+					kind:      gatewayapi.KindDerefOr(backendRef.Kind, resource.KindService),
+					namespace: backendNamespace,
+					name:      string(backendRef.Name),
+				}
+				refGrant, err := r.findReferenceGrant(ctx, from, to)
+				switch {
+				case err != nil:
+					r.log.Error(err, "failed to find ReferenceGrant")
+				case refGrant == nil:
+					r.log.Info("no matching ReferenceGrants found", "from", from.kind,
+						"from namespace", from.namespace, "target", to.kind, "target namespace", to.namespace)
+				default:
+					if !resourceMap.allAssociatedReferenceGrants.Has(utils.NamespacedName(refGrant).String()) {
+						resourceMap.allAssociatedReferenceGrants.Insert(utils.NamespacedName(refGrant).String())
+						resourceTree.ReferenceGrants = append(resourceTree.ReferenceGrants, refGrant)
+						r.log.Info("added ReferenceGrant to resource map", "namespace", refGrant.Namespace,
+							"name", refGrant.Name)
+					}
+				}
+			}
+		}
+	}
+}
+
 func (r *gatewayAPIReconciler) getNamespace(ctx context.Context, name string) (*corev1.Namespace, error) {
 	nsKey := types.NamespacedName{Name: name}
 	ns := new(corev1.Namespace)
@@ -921,6 +1124,10 @@ func (r *gatewayAPIReconciler) processGateways(ctx context.Context, managedGC *g
 			r.log.Error(err, "failed to process infrastructure.parametersRef for gateway", "namespace", gtw.Namespace, "name", gtw.Name)
 		}
 
+		if err := r.processEnvoyProxyEndpointSlices(ctx, &gtw, resourceMap, resourceTree); err != nil {
+			r.log.Error(err, "failed to process EndpointSlices of the Envoy service for gateway", "namespace", gtw.Namespace, "name", gtw.Name)
+		}
+
 		for _, listener := range gtw.Spec.Listeners {
 			// Get Secret for gateway if it exists.
 			if terminatesTLS(&listener) {
@@ -1054,6 +1261,7 @@ func (r *gatewayAPIReconciler) processBackendTrafficPolicies(ctx context.Context
 		}
 	}
 	r.processBtpConfigMapRefs(ctx, resourceTree, resourceMap)
+	r.processBtpObjectRefs(ctx, resourceTree, resourceMap)
 	return nil
 }
 
@@ -2074,6 +2282,8 @@ func (r *gatewayAPIReconciler) processExtensionServerPolicies(
 // to the resourceTree
 // - BackendRefs for ExtProcs
 // - SecretRefs for Wasms
+// - ConfigMapRefs for Luas
+// - ConfigMapRefs and SecretRefs for the gRPC-JSON transcoder descriptor sets
 func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 	ctx context.Context, resourceTree *resource.Resources, resourceMap *resourceMappings,
 ) {
@@ -2145,5 +2355,51 @@ func (r *gatewayAPIReconciler) processEnvoyExtensionPolicyObjectRefs(
 				}
 			}
 		}
+
+		// Add the referenced ConfigMaps in Luas to the resourceTree
+		for _, l := range policy.Spec.Lua {
+			if l.ValueRef != nil && string(l.ValueRef.Kind) == resource.KindConfigMap {
+				if err := r.processConfigMapRef(
+					ctx,
+					resourceMap,
+					resourceTree,
+					resource.KindEnvoyExtensionPolicy,
+					policy.Namespace,
+					policy.Name,
+					gwapiv1.SecretObjectReference{
+						Group: &l.ValueRef.Group,
+						Kind:  &l.ValueRef.Kind,
+						Name:  l.ValueRef.Name,
+					}); err != nil {
+					r.log.Error(err,
+						"failed to process Lua ValueRef for EnvoyExtensionPolicy",
+						"policy", policy, "valueRef", l.ValueRef)
+				}
+			}
+		}
+
+		// Add the referenced ConfigMap or Secret of the gRPC-JSON transcoder descriptor
+		// set to the resourceTree
+		if ds := protoDescriptorSetRef(policy); ds != nil {
+			ref := gwapiv1.SecretObjectReference{
+				Group: &ds.ValueRef.Group,
+				Kind:  &ds.ValueRef.Kind,
+				Name:  ds.ValueRef.Name,
+			}
+			var err error
+			switch string(ds.ValueRef.Kind) {
+			case resource.KindConfigMap:
+				err = r.processConfigMapRef(ctx, resourceMap, resourceTree,
+					resource.KindEnvoyExtensionPolicy, policy.Namespace, policy.Name, ref)
+			case resource.KindSecret:
+				err = r.processSecretRef(ctx, resourceMap, resourceTree,
+					resource.KindEnvoyExtensionPolicy, policy.Namespace, policy.Name, ref)
+			}
+			if err != nil {
+				r.log.Error(err,
+					"failed to process gRPC-JSON transcoder descriptor set ValueRef for EnvoyExtensionPolicy",
+					"policy", policy, "valueRef", ds.ValueRef)
+			}
+		}
 	}
 }
@@ -23,32 +23,37 @@ import (
 )
 
 const (
-	classGatewayIndex                = "classGatewayIndex"
-	gatewayTLSRouteIndex             = "gatewayTLSRouteIndex"
-	gatewayHTTPRouteIndex            = "gatewayHTTPRouteIndex"
-	gatewayGRPCRouteIndex            = "gatewayGRPCRouteIndex"
-	gatewayTCPRouteIndex             = "gatewayTCPRouteIndex"
-	gatewayUDPRouteIndex             = "gatewayUDPRouteIndex"
-	secretGatewayIndex               = "secretGatewayIndex"
-	targetRefGrantRouteIndex         = "targetRefGrantRouteIndex"
-	backendHTTPRouteIndex            = "backendHTTPRouteIndex"
-	backendGRPCRouteIndex            = "backendGRPCRouteIndex"
-	backendTLSRouteIndex             = "backendTLSRouteIndex"
-	backendTCPRouteIndex             = "backendTCPRouteIndex"
-	backendUDPRouteIndex             = "backendUDPRouteIndex"
-	secretSecurityPolicyIndex        = "secretSecurityPolicyIndex"
-	backendSecurityPolicyIndex       = "backendSecurityPolicyIndex"
-	configMapCtpIndex                = "configMapCtpIndex"
-	secretCtpIndex                   = "secretCtpIndex"
-	secretBtlsIndex                  = "secretBtlsIndex"
-	configMapBtlsIndex               = "configMapBtlsIndex"
-	backendEnvoyExtensionPolicyIndex = "backendEnvoyExtensionPolicyIndex"
-	backendEnvoyProxyTelemetryIndex  = "backendEnvoyProxyTelemetryIndex"
-	secretEnvoyProxyIndex            = "secretEnvoyProxyIndex"
-	secretEnvoyExtensionPolicyIndex  = "secretEnvoyExtensionPolicyIndex"
-	httpRouteFilterHTTPRouteIndex    = "httpRouteFilterHTTPRouteIndex"
-	configMapBtpIndex                = "configMapBtpIndex"
-	configMapHTTPRouteFilterIndex    = "configMapHTTPRouteFilterIndex"
+	classGatewayIndex                  = "classGatewayIndex"
+	gatewayTLSRouteIndex               = "gatewayTLSRouteIndex"
+	gatewayHTTPRouteIndex              = "gatewayHTTPRouteIndex"
+	gatewayGRPCRouteIndex              = "gatewayGRPCRouteIndex"
+	gatewayTCPRouteIndex               = "gatewayTCPRouteIndex"
+	gatewayUDPRouteIndex               = "gatewayUDPRouteIndex"
+	secretGatewayIndex                 = "secretGatewayIndex"
+	targetRefGrantRouteIndex           = "targetRefGrantRouteIndex"
+	backendHTTPRouteIndex              = "backendHTTPRouteIndex"
+	backendGRPCRouteIndex              = "backendGRPCRouteIndex"
+	backendTLSRouteIndex               = "backendTLSRouteIndex"
+	backendTCPRouteIndex               = "backendTCPRouteIndex"
+	backendUDPRouteIndex               = "backendUDPRouteIndex"
+	secretSecurityPolicyIndex          = "secretSecurityPolicyIndex"
+	configMapSecurityPolicyIndex       = "configMapSecurityPolicyIndex"
+	backendSecurityPolicyIndex         = "backendSecurityPolicyIndex"
+	configMapCtpIndex                  = "configMapCtpIndex"
+	secretCtpIndex                     = "secretCtpIndex"
+	secretBtlsIndex                    = "secretBtlsIndex"
+	configMapBtlsIndex                 = "configMapBtlsIndex"
+	backendEnvoyExtensionPolicyIndex   = "backendEnvoyExtensionPolicyIndex"
+	backendEnvoyProxyTelemetryIndex    = "backendEnvoyProxyTelemetryIndex"
+	secretEnvoyProxyIndex              = "secretEnvoyProxyIndex"
+	secretEnvoyExtensionPolicyIndex    = "secretEnvoyExtensionPolicyIndex"
+	configMapEnvoyExtensionPolicyIndex = "configMapEnvoyExtensionPolicyIndex"
+	httpRouteFilterHTTPRouteIndex      = "httpRouteFilterHTTPRouteIndex"
+	configMapBtpIndex                  = "configMapBtpIndex"
+	secretBtpIndex                     = "secretBtpIndex"
+	backendBtpIndex                    = "backendBtpIndex"
+	configMapHTTPRouteFilterIndex      = "configMapHTTPRouteFilterIndex"
+	secretHTTPRouteFilterIndex         = "secretHTTPRouteFilterIndex"
 )
 
 func addReferenceGrantIndexers(ctx context.Context, mgr manager.Manager) error {
@@ -511,8 +516,13 @@ func secretGatewayIndexFunc(rawObj client.Object) []string {
 
 // addSecurityPolicyIndexers adds indexing on SecurityPolicy.
 //   - For Secret objects that are referenced in SecurityPolicy objects via
-//     `.spec.OIDC.clientSecret` and `.spec.basicAuth.users`. This helps in
-//     querying for SecurityPolicies that are affected by a particular Secret CRUD.
+//     `.spec.OIDC.clientSecret`, `.spec.basicAuth.users`, `.spec.apiKeyAuth.credentialRefs`
+//     and `.spec.jwt.providers.localJWKS.valueRef`.
+//     This helps in querying for SecurityPolicies that are affected by a particular Secret CRUD.
+//   - For ConfigMap objects that are referenced in SecurityPolicy objects via
+//     `.spec.jwt.providers.localJWKS.valueRef` and
+//     `.spec.authorization.rules.principal.clientCIDRsFrom.configMap`. This helps in querying for
+//     SecurityPolicies that are affected by a particular ConfigMap CRUD.
 //   - For Service objects that are referenced in SecurityPolicy objects via
 //     `.spec.extAuth.http.backendObjectReference`. This helps in querying for
 //     SecurityPolicies that are affected by a particular Service CRUD.
@@ -525,6 +535,12 @@ func addSecurityPolicyIndexers(ctx context.Context, mgr manager.Manager) error {
 		return err
 	}
 
+	if err = mgr.GetFieldIndexer().IndexField(
+		ctx, &egv1a1.SecurityPolicy{}, configMapSecurityPolicyIndex,
+		configMapSecurityPolicyIndexFunc); err != nil {
+		return err
+	}
+
 	if err = mgr.GetFieldIndexer().IndexField(
 		ctx, &egv1a1.SecurityPolicy{}, backendSecurityPolicyIndex,
 		backendSecurityPolicyIndexFunc); err != nil {
@@ -548,6 +564,12 @@ func secretSecurityPolicyIndexFunc(rawObj client.Object) []string {
 	if securityPolicy.Spec.BasicAuth != nil {
 		secretReferences = append(secretReferences, securityPolicy.Spec.BasicAuth.Users)
 	}
+	if securityPolicy.Spec.APIKeyAuth != nil {
+		secretReferences = append(secretReferences, securityPolicy.Spec.APIKeyAuth.CredentialRefs...)
+	}
+	if securityPolicy.Spec.HMACAuth != nil {
+		secretReferences = append(secretReferences, securityPolicy.Spec.HMACAuth.SecretRef)
+	}
 
 	for _, reference := range secretReferences {
 		values = append(values,
@@ -557,6 +579,68 @@ func secretSecurityPolicyIndexFunc(rawObj client.Object) []string {
 			}.String(),
 		)
 	}
+	values = append(values, localJWKSReferences(securityPolicy, resource.KindSecret)...)
+	return values
+}
+
+func configMapSecurityPolicyIndexFunc(rawObj client.Object) []string {
+	securityPolicy := rawObj.(*egv1a1.SecurityPolicy)
+	values := localJWKSReferences(securityPolicy, resource.KindConfigMap)
+	for _, name := range cidrListConfigMapReferences(securityPolicy) {
+		values = append(values,
+			types.NamespacedName{
+				Namespace: securityPolicy.Namespace,
+				Name:      string(name),
+			}.String(),
+		)
+	}
+	return values
+}
+
+// cidrListConfigMapReferences returns the names of the ConfigMaps referenced by
+// the client CIDR list sources of the authorization rules in a SecurityPolicy.
+func cidrListConfigMapReferences(securityPolicy *egv1a1.SecurityPolicy) []gwapiv1.ObjectName {
+	var names []gwapiv1.ObjectName
+
+	if securityPolicy.Spec.Authorization == nil {
+		return names
+	}
+
+	for _, rule := range securityPolicy.Spec.Authorization.Rules {
+		if rule.Principal == nil {
+			continue
+		}
+		for _, source := range rule.Principal.ClientCIDRsFrom {
+			if source.Type == egv1a1.CIDRListSourceTypeConfigMap && source.ConfigMap != nil {
+				names = append(names, source.ConfigMap.Name)
+			}
+		}
+	}
+	return names
+}
+
+// localJWKSReferences returns the namespaced names of the objects of the given kind
+// referenced by the local JWKS of the JWT providers in a SecurityPolicy.
+func localJWKSReferences(securityPolicy *egv1a1.SecurityPolicy, kind string) []string {
+	var values []string
+
+	if securityPolicy.Spec.JWT == nil {
+		return values
+	}
+
+	for _, provider := range securityPolicy.Spec.JWT.Providers {
+		if provider.LocalJWKS == nil || provider.LocalJWKS.ValueRef == nil {
+			continue
+		}
+		if string(provider.LocalJWKS.ValueRef.Kind) == kind {
+			values = append(values,
+				types.NamespacedName{
+					Namespace: securityPolicy.Namespace,
+					Name:      string(provider.LocalJWKS.ValueRef.Name),
+				}.String(),
+			)
+		}
+	}
 	return values
 }
 
@@ -656,9 +740,52 @@ func addBtpIndexers(ctx context.Context, mgr manager.Manager) error {
 		return err
 	}
 
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.BackendTrafficPolicy{}, secretBtpIndex, secretBtpIndexFunc); err != nil {
+		return err
+	}
+
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.BackendTrafficPolicy{}, backendBtpIndex, backendBtpIndexFunc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+func secretBtpIndexFunc(rawObj client.Object) []string {
+	btp := rawObj.(*egv1a1.BackendTrafficPolicy)
+	var secretReferences []string
+
+	if btp.Spec.OAuth2ClientCredentials != nil {
+		secretRef := btp.Spec.OAuth2ClientCredentials.ClientSecret
+		if secretRef.Kind == nil || string(*secretRef.Kind) == resource.KindSecret {
+			secretReferences = append(secretReferences,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(secretRef.Namespace, btp.Namespace),
+					Name:      string(secretRef.Name),
+				}.String(),
+			)
+		}
+	}
+	return secretReferences
+}
+
+func backendBtpIndexFunc(rawObj client.Object) []string {
+	btp := rawObj.(*egv1a1.BackendTrafficPolicy)
+	var backendReferences []string
+
+	if btp.Spec.OAuth2ClientCredentials != nil {
+		for _, ref := range btp.Spec.OAuth2ClientCredentials.BackendRefs {
+			backendReferences = append(backendReferences,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(ref.Namespace, btp.Namespace),
+					Name:      string(ref.Name),
+				}.String(),
+			)
+		}
+	}
+	return backendReferences
+}
+
 func configMapBtpIndexFunc(rawObj client.Object) []string {
 	btp := rawObj.(*egv1a1.BackendTrafficPolicy)
 	var configMapReferences []string
@@ -678,14 +805,18 @@ func configMapBtpIndexFunc(rawObj client.Object) []string {
 	return configMapReferences
 }
 
-// addRouteFilterIndexers adds indexing on HTTPRouteFilter, for ConfigMap objects that are
+// addRouteFilterIndexers adds indexing on HTTPRouteFilter, for ConfigMap and Secret objects that are
 // referenced in HTTPRouteFilter objects. This helps in querying for HTTPRouteFilters that are
-// affected by a particular ConfigMap CRUD.
+// affected by a particular ConfigMap or Secret CRUD.
 func addRouteFilterIndexers(ctx context.Context, mgr manager.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.HTTPRouteFilter{},
 		configMapHTTPRouteFilterIndex, configMapRouteFilterIndexFunc); err != nil {
 		return err
 	}
+	if err := mgr.GetFieldIndexer().IndexField(ctx, &egv1a1.HTTPRouteFilter{},
+		secretHTTPRouteFilterIndex, secretRouteFilterIndexFunc); err != nil {
+		return err
+	}
 	return nil
 }
 
@@ -707,6 +838,23 @@ func configMapRouteFilterIndexFunc(rawObj client.Object) []string {
 	return configMapReferences
 }
 
+func secretRouteFilterIndexFunc(rawObj client.Object) []string {
+	filter := rawObj.(*egv1a1.HTTPRouteFilter)
+	var secretReferences []string
+	if filter.Spec.CredentialInjection != nil {
+		valueRef := filter.Spec.CredentialInjection.Credential.ValueRef
+		if valueRef.Kind == nil || string(*valueRef.Kind) == resource.KindSecret {
+			secretReferences = append(secretReferences,
+				types.NamespacedName{
+					Namespace: gatewayapi.NamespaceDerefOr(valueRef.Namespace, filter.Namespace),
+					Name:      string(valueRef.Name),
+				}.String(),
+			)
+		}
+	}
+	return secretReferences
+}
+
 // addBtlsIndexers adds indexing on BackendTLSPolicy, for ConfigMap and Secret objects that are
 // referenced in BackendTLSPolicy objects. This helps in querying for BackendTLSPolicies that are
 // affected by a particular ConfigMap CRUD.
@@ -761,6 +909,12 @@ func secretBtlsIndexFunc(rawObj client.Object) []string {
 //   - For Service objects that are referenced in EnvoyExtensionPolicy objects via
 //     `.spec.extProc.[*].service.backendObjectReference`. This helps in querying for
 //     EnvoyExtensionPolicy that are affected by a particular Service CRUD.
+//   - For Secret objects that are referenced in EnvoyExtensionPolicy objects via
+//     `.spec.wasm.[*].code.image.pullSecretRef` and `.spec.grpcJSONTranscoder.protoDescriptorSet.valueRef`.
+//     This helps in querying for EnvoyExtensionPolicy that are affected by a particular Secret CRUD.
+//   - For ConfigMap objects that are referenced in EnvoyExtensionPolicy objects via
+//     `.spec.lua.[*].valueRef` and `.spec.grpcJSONTranscoder.protoDescriptorSet.valueRef`.
+//     This helps in querying for EnvoyExtensionPolicy that are affected by a particular ConfigMap CRUD.
 func addEnvoyExtensionPolicyIndexers(ctx context.Context, mgr manager.Manager) error {
 	var err error
 
@@ -776,6 +930,12 @@ func addEnvoyExtensionPolicyIndexers(ctx context.Context, mgr manager.Manager) e
 		return err
 	}
 
+	if err = mgr.GetFieldIndexer().IndexField(
+		ctx, &egv1a1.EnvoyExtensionPolicy{}, configMapEnvoyExtensionPolicyIndex,
+		configMapEnvoyExtensionPolicyIndexFunc); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -814,5 +974,48 @@ func secretEnvoyExtensionPolicyIndexFunc(rawObj client.Object) []string {
 		}
 	}
 
+	if ds := protoDescriptorSetRef(envoyExtensionPolicy); ds != nil && string(ds.ValueRef.Kind) == resource.KindSecret {
+		ret = append(ret,
+			types.NamespacedName{
+				Namespace: envoyExtensionPolicy.Namespace,
+				Name:      string(ds.ValueRef.Name),
+			}.String())
+	}
+
+	return ret
+}
+
+func configMapEnvoyExtensionPolicyIndexFunc(rawObj client.Object) []string {
+	envoyExtensionPolicy := rawObj.(*egv1a1.EnvoyExtensionPolicy)
+
+	var ret []string
+
+	for _, l := range envoyExtensionPolicy.Spec.Lua {
+		if l.ValueRef != nil && string(l.ValueRef.Kind) == resource.KindConfigMap {
+			ret = append(ret,
+				types.NamespacedName{
+					Namespace: envoyExtensionPolicy.Namespace,
+					Name:      string(l.ValueRef.Name),
+				}.String())
+		}
+	}
+
+	if ds := protoDescriptorSetRef(envoyExtensionPolicy); ds != nil && string(ds.ValueRef.Kind) == resource.KindConfigMap {
+		ret = append(ret,
+			types.NamespacedName{
+				Namespace: envoyExtensionPolicy.Namespace,
+				Name:      string(ds.ValueRef.Name),
+			}.String())
+	}
+
 	return ret
 }
+
+// protoDescriptorSetRef returns the proto descriptor set of the gRPC-JSON transcoder
+// of the provided EnvoyExtensionPolicy, if any.
+func protoDescriptorSetRef(envoyExtensionPolicy *egv1a1.EnvoyExtensionPolicy) *egv1a1.ProtoDescriptorSet {
+	if envoyExtensionPolicy.Spec.GRPCJSONTranscoder == nil {
+		return nil
+	}
+	return &envoyExtensionPolicy.Spec.GRPCJSONTranscoder.ProtoDescriptorSet
+}
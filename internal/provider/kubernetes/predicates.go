@@ -26,6 +26,7 @@ import (
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
 	"github.com/envoyproxy/gateway/internal/utils"
 )
 
@@ -178,9 +179,45 @@ func (r *gatewayAPIReconciler) validateSecretForReconcile(obj client.Object) boo
 		}
 	}
 
+	if r.hrfCRDExists {
+		if r.isHTTPRouteFilterReferencingSecret(&nsName) {
+			return true
+		}
+	}
+
+	if r.btpCRDExists {
+		if r.isBackendTrafficPolicyReferencingSecret(&nsName) {
+			return true
+		}
+	}
+
 	return false
 }
 
+func (r *gatewayAPIReconciler) isBackendTrafficPolicyReferencingSecret(nsName *types.NamespacedName) bool {
+	btpList := &egv1a1.BackendTrafficPolicyList{}
+	if err := r.client.List(context.Background(), btpList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(secretBtpIndex, nsName.String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated BackendTrafficPolicies")
+		return false
+	}
+
+	return len(btpList.Items) > 0
+}
+
+func (r *gatewayAPIReconciler) isHTTPRouteFilterReferencingSecret(nsName *types.NamespacedName) bool {
+	routeFilterList := &egv1a1.HTTPRouteFilterList{}
+	if err := r.client.List(context.Background(), routeFilterList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(secretHTTPRouteFilterIndex, nsName.String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated HTTPRouteFilter")
+		return false
+	}
+
+	return len(routeFilterList.Items) > 0
+}
+
 func (r *gatewayAPIReconciler) isBackendTLSPolicyReferencingSecret(nsName *types.NamespacedName) bool {
 	btlsList := &gwapiv1a3.BackendTLSPolicyList{}
 	if err := r.client.List(context.Background(), btlsList, &client.ListOptions{
@@ -319,6 +356,12 @@ func (r *gatewayAPIReconciler) validateServiceForReconcile(obj client.Object) bo
 		}
 	}
 
+	if r.btpCRDExists {
+		if r.isBackendTrafficPolicyReferencingBackend(&nsName) {
+			return true
+		}
+	}
+
 	if r.epCRDExists {
 		if r.isEnvoyProxyReferencingBackend(&nsName) {
 			return true
@@ -355,6 +398,12 @@ func (r *gatewayAPIReconciler) validateBackendForReconcile(obj client.Object) bo
 		}
 	}
 
+	if r.btpCRDExists {
+		if r.isBackendTrafficPolicyReferencingBackend(&nsName) {
+			return true
+		}
+	}
+
 	if r.epCRDExists {
 		if r.isEnvoyProxyReferencingBackend(&nsName) {
 			return true
@@ -382,6 +431,18 @@ func (r *gatewayAPIReconciler) isSecurityPolicyReferencingBackend(nsName *types.
 	return len(spList.Items) > 0
 }
 
+func (r *gatewayAPIReconciler) isBackendTrafficPolicyReferencingBackend(nsName *types.NamespacedName) bool {
+	btpList := &egv1a1.BackendTrafficPolicyList{}
+	if err := r.client.List(context.Background(), btpList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(backendBtpIndex, nsName.String()),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated BackendTrafficPolicies")
+		return false
+	}
+
+	return len(btpList.Items) > 0
+}
+
 // validateServiceImportForReconcile tries finding the owning Gateway of the ServiceImport
 // if it exists, finds the Gateway's Deployment, and further updates the Gateway
 // status Ready condition. All Services are pushed for reconciliation.
@@ -482,6 +543,12 @@ func (r *gatewayAPIReconciler) validateEndpointSliceForReconcile(obj client.Obje
 		return false
 	}
 
+	// The EndpointSlices of the Envoy services hold the zones of the Envoy proxies,
+	// which are used by the zone-aware routing.
+	if r.isEnvoyProxyEndpointSlice(ep) {
+		return true
+	}
+
 	nsName := types.NamespacedName{
 		Namespace: obj.GetNamespace(),
 		Name:      svcName,
@@ -501,6 +568,12 @@ func (r *gatewayAPIReconciler) validateEndpointSliceForReconcile(obj client.Obje
 		}
 	}
 
+	if r.btpCRDExists {
+		if r.isBackendTrafficPolicyReferencingBackend(&nsName) {
+			return true
+		}
+	}
+
 	if r.epCRDExists {
 		if r.isEnvoyProxyReferencingBackend(&nsName) {
 			return true
@@ -516,6 +589,120 @@ func (r *gatewayAPIReconciler) validateEndpointSliceForReconcile(obj client.Obje
 	return false
 }
 
+// isEnvoyProxyEndpointSlice returns true if the endpointSlice belongs to the service
+// of the Envoy proxies of a Gateway or of a merged GatewayClass, and the Gateway uses
+// the zone-aware routing.
+func (r *gatewayAPIReconciler) isEnvoyProxyEndpointSlice(ep *discoveryv1.EndpointSlice) bool {
+	if ep.Namespace != r.namespace || !r.btpCRDExists {
+		return false
+	}
+	ctx := context.Background()
+	labels := ep.GetLabels()
+	if gtw := r.findOwningGateway(ctx, labels); gtw != nil {
+		return r.isZoneAwareRoutingUsed(ctx, gtw)
+	}
+
+	gcName, ok := labels[gatewayapi.OwningGatewayClassLabel]
+	if !ok || !r.mergeGateways.Has(gcName) {
+		return false
+	}
+	gatewayList := new(gwapiv1.GatewayList)
+	if err := r.client.List(ctx, gatewayList, &client.ListOptions{
+		FieldSelector: fields.OneTermEqualSelector(classGatewayIndex, gcName),
+	}); err != nil {
+		r.log.Error(err, "unable to find associated Gateways", "gatewayClass", gcName)
+		return false
+	}
+	for i := range gatewayList.Items {
+		if r.isZoneAwareRoutingUsed(ctx, &gatewayList.Items[i]) {
+			return true
+		}
+	}
+	return false
+}
+
+// isZoneAwareRoutingUsed returns true if a BackendTrafficPolicy that prefers the local
+// zone targets the Gateway or a route attached to the Gateway.
+// The policies that select their targets by labels are assumed to target the Gateway.
+func (r *gatewayAPIReconciler) isZoneAwareRoutingUsed(ctx context.Context, gtw *gwapiv1.Gateway) bool {
+	btpList := &egv1a1.BackendTrafficPolicyList{}
+	if err := r.client.List(ctx, btpList); err != nil {
+		r.log.Error(err, "unable to find associated BackendTrafficPolicies")
+		return false
+	}
+
+	for _, btp := range btpList.Items {
+		lb := btp.Spec.LoadBalancer
+		if lb == nil || lb.ZoneAware == nil || lb.ZoneAware.PreferLocal == nil {
+			continue
+		}
+		if len(btp.Spec.TargetSelectors) > 0 {
+			return true
+		}
+		for _, ref := range btp.Spec.GetTargetRefs() {
+			nsName := types.NamespacedName{Namespace: btp.Namespace, Name: string(ref.Name)}
+			if string(ref.Kind) == resource.KindGateway {
+				if nsName.Namespace == gtw.Namespace && nsName.Name == gtw.Name {
+					return true
+				}
+				continue
+			}
+			if r.isRouteAttachedToGateway(ctx, string(ref.Kind), nsName, gtw) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// isRouteAttachedToGateway returns true if the route of the provided kind references
+// the Gateway in its parentRefs.
+func (r *gatewayAPIReconciler) isRouteAttachedToGateway(ctx context.Context, kind string,
+	nsName types.NamespacedName, gtw *gwapiv1.Gateway,
+) bool {
+	var (
+		route      client.Object
+		parentRefs func() []gwapiv1.ParentReference
+	)
+	switch kind {
+	case resource.KindHTTPRoute:
+		httpRoute := new(gwapiv1.HTTPRoute)
+		route, parentRefs = httpRoute, func() []gwapiv1.ParentReference { return httpRoute.Spec.ParentRefs }
+	case resource.KindGRPCRoute:
+		if !r.grpcRouteCRDExists {
+			return false
+		}
+		grpcRoute := new(gwapiv1.GRPCRoute)
+		route, parentRefs = grpcRoute, func() []gwapiv1.ParentReference { return grpcRoute.Spec.ParentRefs }
+	case resource.KindTCPRoute:
+		if !r.tcpRouteCRDExists {
+			return false
+		}
+		tcpRoute := new(gwapiv1a2.TCPRoute)
+		route, parentRefs = tcpRoute, func() []gwapiv1.ParentReference { return tcpRoute.Spec.ParentRefs }
+	case resource.KindUDPRoute:
+		if !r.udpRouteCRDExists {
+			return false
+		}
+		udpRoute := new(gwapiv1a2.UDPRoute)
+		route, parentRefs = udpRoute, func() []gwapiv1.ParentReference { return udpRoute.Spec.ParentRefs }
+	default:
+		return false
+	}
+
+	if err := r.client.Get(ctx, nsName, route); err != nil {
+		return false
+	}
+	for _, parent := range parentRefs() {
+		if (parent.Kind == nil || string(*parent.Kind) == resource.KindGateway) &&
+			gatewayapi.NamespaceDerefOr(parent.Namespace, nsName.Namespace) == gtw.Namespace &&
+			string(parent.Name) == gtw.Name {
+			return true
+		}
+	}
+	return false
+}
+
 // validateObjectForReconcile tries finding the owning Gateway of the Deployment or DaemonSet
 // if it exists, finds the Gateway's Service, and further updates the Gateway
 // status Ready condition. No Deployments or DaemonSets are pushed for reconciliation.
@@ -734,6 +921,20 @@ func (r *gatewayAPIReconciler) validateConfigMapForReconcile(obj client.Object)
 		}
 	}
 
+	if r.spCRDExists {
+		spList := &egv1a1.SecurityPolicyList{}
+		if err := r.client.List(context.Background(), spList, &client.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector(configMapSecurityPolicyIndex, utils.NamespacedName(configMap).String()),
+		}); err != nil {
+			r.log.Error(err, "unable to find associated SecurityPolicy")
+			return false
+		}
+
+		if len(spList.Items) > 0 {
+			return true
+		}
+	}
+
 	if r.hrfCRDExists {
 		routeFilterList := &egv1a1.HTTPRouteFilterList{}
 		if err := r.client.List(context.Background(), routeFilterList, &client.ListOptions{
@@ -748,6 +949,20 @@ func (r *gatewayAPIReconciler) validateConfigMapForReconcile(obj client.Object)
 		}
 	}
 
+	if r.eepCRDExists {
+		eepList := &egv1a1.EnvoyExtensionPolicyList{}
+		if err := r.client.List(context.Background(), eepList, &client.ListOptions{
+			FieldSelector: fields.OneTermEqualSelector(configMapEnvoyExtensionPolicyIndex, utils.NamespacedName(configMap).String()),
+		}); err != nil {
+			r.log.Error(err, "unable to find associated EnvoyExtensionPolicy")
+			return false
+		}
+
+		if len(eepList.Items) > 0 {
+			return true
+		}
+	}
+
 	return false
 }
 
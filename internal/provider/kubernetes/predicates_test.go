@@ -300,6 +300,110 @@ func TestValidateSecretForReconcile(t *testing.T) {
 			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
 			expect: true,
 		},
+		{
+			name: "references SecurityPolicy API Key Auth",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Name: "scheduled-status-test"}, "test-gc", 8080),
+				&egv1a1.SecurityPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "api-key-auth",
+					},
+					Spec: egv1a1.SecurityPolicySpec{
+						PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+							TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+									Kind: "Gateway",
+									Name: "scheduled-status-test",
+								},
+							},
+						},
+						APIKeyAuth: &egv1a1.APIKeyAuth{
+							CredentialRefs: []gwapiv1.SecretObjectReference{
+								{
+									Name: "secret",
+								},
+							},
+							ExtractFrom: []*egv1a1.ExtractFrom{
+								{
+									Headers: []string{"x-api-key"},
+								},
+							},
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
+		{
+			name: "references SecurityPolicy HMAC Auth",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Name: "scheduled-status-test"}, "test-gc", 8080),
+				&egv1a1.SecurityPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "hmac-auth",
+					},
+					Spec: egv1a1.SecurityPolicySpec{
+						PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+							TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+									Kind: "Gateway",
+									Name: "scheduled-status-test",
+								},
+							},
+						},
+						HMACAuth: &egv1a1.HMACAuth{
+							SecretRef: gwapiv1.SecretObjectReference{
+								Name: "secret",
+							},
+							SignatureHeader: "x-signature",
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
+		{
+			name: "references SecurityPolicy JWT Local JWKS",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Name: "scheduled-status-test"}, "test-gc", 8080),
+				&egv1a1.SecurityPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "jwt-local-jwks",
+					},
+					Spec: egv1a1.SecurityPolicySpec{
+						PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+							TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+									Kind: "Gateway",
+									Name: "scheduled-status-test",
+								},
+							},
+						},
+						JWT: &egv1a1.JWT{
+							Providers: []egv1a1.JWTProvider{
+								{
+									Name: "example",
+									LocalJWKS: &egv1a1.LocalJWKS{
+										Type: ptr.To(egv1a1.LocalJWKSTypeValueRef),
+										ValueRef: &gwapiv1.LocalObjectReference{
+											Kind: "Secret",
+											Name: "secret",
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
 		{
 			name: "secret is not referenced by any EG CRs",
 			configs: []client.Object{
@@ -349,6 +453,82 @@ func TestValidateSecretForReconcile(t *testing.T) {
 			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
 			expect: true,
 		},
+		{
+			name: "references EnvoyExtensionPolicy gRPC-JSON transcoder descriptor set",
+			configs: []client.Object{
+				&egv1a1.EnvoyExtensionPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "grpc-json-transcoder",
+					},
+					Spec: egv1a1.EnvoyExtensionPolicySpec{
+						PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+							TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+								{
+									LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+										Kind: "Gateway",
+										Name: "scheduled-status-test",
+									},
+								},
+							},
+						},
+						GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+							ProtoDescriptorSet: egv1a1.ProtoDescriptorSet{
+								ValueRef: gwapiv1.LocalObjectReference{
+									Kind: "Secret",
+									Name: "secret",
+								},
+								Key: "descriptor.pb",
+							},
+							Services: []string{"helloworld.Greeter"},
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
+		{
+			name: "references HTTPRouteFilter Credential Injection",
+			configs: []client.Object{
+				&egv1a1.HTTPRouteFilter{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "credential-injection",
+					},
+					Spec: egv1a1.HTTPRouteFilterSpec{
+						CredentialInjection: &egv1a1.HTTPCredentialInjectionFilter{
+							Credential: egv1a1.InjectedCredential{
+								ValueRef: gwapiv1.SecretObjectReference{
+									Name: "secret",
+								},
+							},
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
+		{
+			name: "references BackendTrafficPolicy OAuth2 Client Credentials",
+			configs: []client.Object{
+				&egv1a1.BackendTrafficPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "oauth2-client-credentials",
+					},
+					Spec: egv1a1.BackendTrafficPolicySpec{
+						OAuth2ClientCredentials: &egv1a1.OAuth2ClientCredentials{
+							TokenEndpoint: "https://oauth.example.com/token",
+							ClientID:      "client-id",
+							ClientSecret: gwapiv1.SecretObjectReference{
+								Name: "secret",
+							},
+						},
+					},
+				},
+			},
+			secret: test.GetSecret(types.NamespacedName{Name: "secret"}),
+			expect: true,
+		},
 	}
 
 	// Create the reconciler.
@@ -360,6 +540,8 @@ func TestValidateSecretForReconcile(t *testing.T) {
 		spCRDExists:     true,
 		epCRDExists:     true,
 		eepCRDExists:    true,
+		hrfCRDExists:    true,
+		btpCRDExists:    true,
 	}
 
 	for _, tc := range testCases {
@@ -370,6 +552,8 @@ func TestValidateSecretForReconcile(t *testing.T) {
 			WithIndex(&egv1a1.SecurityPolicy{}, secretSecurityPolicyIndex, secretSecurityPolicyIndexFunc).
 			WithIndex(&egv1a1.EnvoyProxy{}, secretEnvoyProxyIndex, secretEnvoyProxyIndexFunc).
 			WithIndex(&egv1a1.EnvoyExtensionPolicy{}, secretEnvoyExtensionPolicyIndex, secretEnvoyExtensionPolicyIndexFunc).
+			WithIndex(&egv1a1.HTTPRouteFilter{}, secretHTTPRouteFilterIndex, secretRouteFilterIndexFunc).
+			WithIndex(&egv1a1.BackendTrafficPolicy{}, secretBtpIndex, secretBtpIndexFunc).
 			Build()
 		t.Run(tc.name, func(t *testing.T) {
 			res := r.validateSecretForReconcile(tc.secret)
@@ -378,10 +562,224 @@ func TestValidateSecretForReconcile(t *testing.T) {
 	}
 }
 
+// TestValidateConfigMapForReconcile tests the validateConfigMapForReconcile
+// predicate function.
+func TestValidateConfigMapForReconcile(t *testing.T) {
+	cidrListPolicy := &egv1a1.SecurityPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "cidr-list",
+		},
+		Spec: egv1a1.SecurityPolicySpec{
+			PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+				TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+					LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+						Kind: "Gateway",
+						Name: "scheduled-status-test",
+					},
+				},
+			},
+			Authorization: &egv1a1.Authorization{
+				Rules: []egv1a1.AuthorizationRule{
+					{
+						Action: egv1a1.AuthorizationActionDeny,
+						Principal: &egv1a1.Principal{
+							ClientCIDRsFrom: []egv1a1.CIDRListSource{
+								{
+									Type: egv1a1.CIDRListSourceTypeConfigMap,
+									ConfigMap: &egv1a1.CIDRListConfigMapReference{
+										Name: "blocklist",
+										Key:  "cidrs",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	luaPolicy := &egv1a1.EnvoyExtensionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "lua",
+		},
+		Spec: egv1a1.EnvoyExtensionPolicySpec{
+			PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+				TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+					LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+						Kind: "Gateway",
+						Name: "scheduled-status-test",
+					},
+				},
+			},
+			Lua: []egv1a1.Lua{
+				{
+					Type: egv1a1.LuaValueTypeValueRef,
+					ValueRef: &gwapiv1.LocalObjectReference{
+						Kind: "ConfigMap",
+						Name: "lua-script",
+					},
+				},
+			},
+		},
+	}
+
+	grpcJSONTranscoderPolicy := &egv1a1.EnvoyExtensionPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "default",
+			Name:      "grpc-json-transcoder",
+		},
+		Spec: egv1a1.EnvoyExtensionPolicySpec{
+			PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+				TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+					LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+						Kind: "Gateway",
+						Name: "scheduled-status-test",
+					},
+				},
+			},
+			GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+				ProtoDescriptorSet: egv1a1.ProtoDescriptorSet{
+					ValueRef: gwapiv1.LocalObjectReference{
+						Kind: "ConfigMap",
+						Name: "greeter-descriptor",
+					},
+					Key: "descriptor.pb",
+				},
+				Services: []string{"helloworld.Greeter"},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		configs   []client.Object
+		configMap client.Object
+		expect    bool
+	}{
+		{
+			name: "references SecurityPolicy Authorization client CIDR list",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Namespace: "default", Name: "scheduled-status-test"}, "test-gc", 8080),
+				cidrListPolicy,
+			},
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "blocklist",
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "references EnvoyExtensionPolicy Lua",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Namespace: "default", Name: "scheduled-status-test"}, "test-gc", 8080),
+				luaPolicy,
+			},
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "lua-script",
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "references EnvoyExtensionPolicy gRPC-JSON transcoder descriptor set",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Namespace: "default", Name: "scheduled-status-test"}, "test-gc", 8080),
+				grpcJSONTranscoderPolicy,
+			},
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "greeter-descriptor",
+				},
+			},
+			expect: true,
+		},
+		{
+			name: "not referenced by any SecurityPolicy",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				test.GetGateway(types.NamespacedName{Namespace: "default", Name: "scheduled-status-test"}, "test-gc", 8080),
+				cidrListPolicy,
+			},
+			configMap: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: "default",
+					Name:      "allowlist",
+				},
+			},
+			expect: false,
+		},
+	}
+
+	// Create the reconciler.
+	logger := logging.DefaultLogger(egv1a1.LogLevelInfo)
+
+	r := gatewayAPIReconciler{
+		classController: egv1a1.GatewayControllerName,
+		log:             logger,
+		spCRDExists:     true,
+		eepCRDExists:    true,
+	}
+
+	for _, tc := range testCases {
+		r.client = fakeclient.NewClientBuilder().
+			WithScheme(envoygateway.GetScheme()).
+			WithObjects(tc.configs...).
+			WithIndex(&egv1a1.SecurityPolicy{}, configMapSecurityPolicyIndex, configMapSecurityPolicyIndexFunc).
+			WithIndex(&egv1a1.EnvoyExtensionPolicy{}, configMapEnvoyExtensionPolicyIndex, configMapEnvoyExtensionPolicyIndexFunc).
+			Build()
+		t.Run(tc.name, func(t *testing.T) {
+			res := r.validateConfigMapForReconcile(tc.configMap)
+			require.Equal(t, tc.expect, res)
+		})
+	}
+}
+
 // TestValidateEndpointSliceForReconcile tests the validateEndpointSliceForReconcile
 // predicate function.
 func TestValidateEndpointSliceForReconcile(t *testing.T) {
 	sampleGateway := test.GetGateway(types.NamespacedName{Namespace: "default", Name: "scheduled-status-test"}, "test-gc", 8080)
+	envoyEndpointSlice := test.GetEndpointSlice(types.NamespacedName{Namespace: "envoy-gateway-system", Name: "envoy-endpointslice"}, "envoy-service")
+	envoyEndpointSlice.Labels[gatewayapi.OwningGatewayNameLabel] = "scheduled-status-test"
+	envoyEndpointSlice.Labels[gatewayapi.OwningGatewayNamespaceLabel] = "default"
+	zoneAwarePolicy := func(kind, name string) *egv1a1.BackendTrafficPolicy {
+		return &egv1a1.BackendTrafficPolicy{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: "default",
+				Name:      "zone-aware",
+			},
+			Spec: egv1a1.BackendTrafficPolicySpec{
+				PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+					TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+						{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1.GroupName,
+								Kind:  gwapiv1.Kind(kind),
+								Name:  gwapiv1.ObjectName(name),
+							},
+						},
+					},
+				},
+				ClusterSettings: egv1a1.ClusterSettings{
+					LoadBalancer: &egv1a1.LoadBalancer{
+						Type: egv1a1.RoundRobinLoadBalancerType,
+						ZoneAware: &egv1a1.ZoneAware{
+							PreferLocal: &egv1a1.PreferLocalZone{},
+						},
+					},
+				},
+			},
+		}
+	}
 
 	testCases := []struct {
 		name          string
@@ -418,6 +816,46 @@ func TestValidateEndpointSliceForReconcile(t *testing.T) {
 			endpointSlice: test.GetEndpointSlice(types.NamespacedName{Name: "endpointslice"}, "service"),
 			expect:        true,
 		},
+		{
+			name: "envoy service of a gateway without zone-aware routing",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				sampleGateway,
+			},
+			endpointSlice: envoyEndpointSlice,
+			expect:        false,
+		},
+		{
+			name: "envoy service of a gateway targeted by a zone-aware policy",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				sampleGateway,
+				zoneAwarePolicy(resource.KindGateway, "scheduled-status-test"),
+			},
+			endpointSlice: envoyEndpointSlice,
+			expect:        true,
+		},
+		{
+			name: "envoy service of a gateway with a route targeted by a zone-aware policy",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				sampleGateway,
+				test.GetHTTPRoute(types.NamespacedName{Namespace: "default", Name: "httproute-test"}, "scheduled-status-test", types.NamespacedName{Name: "service"}, 80, ""),
+				zoneAwarePolicy(resource.KindHTTPRoute, "httproute-test"),
+			},
+			endpointSlice: envoyEndpointSlice,
+			expect:        true,
+		},
+		{
+			name: "envoy service of a gateway with a zone-aware policy targeting another gateway",
+			configs: []client.Object{
+				test.GetGatewayClass("test-gc", egv1a1.GatewayControllerName, nil),
+				sampleGateway,
+				zoneAwarePolicy(resource.KindGateway, "other-gateway"),
+			},
+			endpointSlice: envoyEndpointSlice,
+			expect:        false,
+		},
 	}
 
 	// Create the reconciler.
@@ -426,6 +864,8 @@ func TestValidateEndpointSliceForReconcile(t *testing.T) {
 	r := gatewayAPIReconciler{
 		classController: egv1a1.GatewayControllerName,
 		log:             logger,
+		namespace:       "envoy-gateway-system",
+		btpCRDExists:    true,
 	}
 
 	for _, tc := range testCases {
@@ -811,6 +1251,44 @@ func TestValidateServiceForReconcile(t *testing.T) {
 			service: test.GetService(types.NamespacedName{Name: "ext-proc-service-unrelated"}, nil, nil),
 			expect:  false,
 		},
+		{
+			name: "service referenced by BackendTrafficPolicy OAuth2 Client Credentials token endpoint",
+			configs: []client.Object{
+				&egv1a1.BackendTrafficPolicy{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "oauth2-client-credentials",
+					},
+					Spec: egv1a1.BackendTrafficPolicySpec{
+						PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+							TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+									Kind: "Gateway",
+									Name: "scheduled-status-test",
+								},
+							},
+						},
+						OAuth2ClientCredentials: &egv1a1.OAuth2ClientCredentials{
+							BackendCluster: egv1a1.BackendCluster{
+								BackendRefs: []egv1a1.BackendRef{
+									{
+										BackendObjectReference: gwapiv1.BackendObjectReference{
+											Name: "oauth2-service",
+										},
+									},
+								},
+							},
+							TokenEndpoint: "http://oauth2-service/token",
+							ClientID:      "client-id",
+							ClientSecret: gwapiv1.SecretObjectReference{
+								Name: "secret",
+							},
+						},
+					},
+				},
+			},
+			service: test.GetService(types.NamespacedName{Name: "oauth2-service"}, nil, nil),
+			expect:  true,
+		},
 		{
 			name: "update status of all gateways under gatewayclass when MergeGateways enabled",
 			configs: []client.Object{
@@ -863,6 +1341,7 @@ func TestValidateServiceForReconcile(t *testing.T) {
 		spCRDExists:        true,
 		eepCRDExists:       true,
 		epCRDExists:        true,
+		btpCRDExists:       true,
 	}
 
 	for _, tc := range testCases {
@@ -877,6 +1356,7 @@ func TestValidateServiceForReconcile(t *testing.T) {
 			WithIndex(&egv1a1.SecurityPolicy{}, backendSecurityPolicyIndex, backendSecurityPolicyIndexFunc).
 			WithIndex(&egv1a1.EnvoyExtensionPolicy{}, backendEnvoyExtensionPolicyIndex, backendEnvoyExtensionPolicyIndexFunc).
 			WithIndex(&egv1a1.EnvoyProxy{}, backendEnvoyProxyTelemetryIndex, backendEnvoyProxyTelemetryIndexFunc).
+			WithIndex(&egv1a1.BackendTrafficPolicy{}, backendBtpIndex, backendBtpIndexFunc).
 			Build()
 		t.Run(tc.name, func(t *testing.T) {
 			res := r.validateServiceForReconcile(tc.service)
@@ -248,6 +248,7 @@ func (r *gatewayAPIReconciler) processHTTPRoutes(ctx context.Context, gatewayNam
 			filter := httpFilters[i]
 			resourceMap.httpRouteFilters[utils.GetNamespacedNameWithGroupKind(&filter)] = &filter
 			r.processRouteFilterConfigMapRef(ctx, &filter, resourceMap, resourceTree)
+			r.processRouteFilterSecretRef(ctx, &filter, resourceMap, resourceTree)
 		}
 	}
 
@@ -5,7 +5,24 @@
 
 package net
 
+import "strings"
+
 const (
 	IPv4ListenerAddress = "0.0.0.0"
 	IPv6ListenerAddress = "::"
 )
+
+// SplitCIDRList splits a newline-separated list of CIDR ranges into its entries.
+// Leading and trailing spaces are trimmed, and empty lines and lines starting
+// with "#" are ignored.
+func SplitCIDRList(data string) []string {
+	var cidrs []string
+	for _, line := range strings.Split(data, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		cidrs = append(cidrs, line)
+	}
+	return cidrs
+}
@@ -0,0 +1,68 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package net
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestSplitCIDRList(t *testing.T) {
+	testCases := []struct {
+		name     string
+		data     string
+		expected []string
+	}{
+		{
+			name:     "empty",
+			data:     "",
+			expected: nil,
+		},
+		{
+			name:     "only blank lines",
+			data:     "\n  \n\t\n",
+			expected: nil,
+		},
+		{
+			name:     "single entry",
+			data:     "10.0.0.0/8",
+			expected: []string{"10.0.0.0/8"},
+		},
+		{
+			name:     "multiple entries",
+			data:     "10.0.0.0/8\n192.168.0.0/16\n2001:db8::/32\n",
+			expected: []string{"10.0.0.0/8", "192.168.0.0/16", "2001:db8::/32"},
+		},
+		{
+			name:     "whitespace is trimmed",
+			data:     "  10.0.0.0/8\t\r\n\t192.168.0.0/16  \r\n",
+			expected: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		},
+		{
+			name:     "comments are ignored",
+			data:     "# private ranges\n10.0.0.0/8\n  # 172.16.0.0/12\n192.168.0.0/16",
+			expected: []string{"10.0.0.0/8", "192.168.0.0/16"},
+		},
+		{
+			name:     "only comments",
+			data:     "# first\n#second\n",
+			expected: nil,
+		},
+		{
+			// The entries are validated by the callers.
+			name:     "invalid entries are kept",
+			data:     "10.0.0.0/8\nnot-a-cidr\n10.0.0.0/33\n10.0.0.0/8 # trailing comment",
+			expected: []string{"10.0.0.0/8", "not-a-cidr", "10.0.0.0/33", "10.0.0.0/8 # trailing comment"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, SplitCIDRList(tc.data))
+		})
+	}
+}
@@ -100,6 +100,10 @@ type bootstrapParameters struct {
 
 	// IPFamily of the Listener
 	IPFamily string
+
+	// LocalCluster defines whether to add the local cluster of the Envoy proxies,
+	// which is used by the zone-aware routing.
+	LocalCluster bool
 }
 
 type serverParameters struct {
@@ -155,6 +159,7 @@ type RenderBootstrapConfigOptions struct {
 	AdminServerPort  *int32
 	ReadyServerPort  *int32
 	MaxHeapSizeBytes uint64
+	LocalCluster     bool
 }
 
 type SdsConfigPath struct {
@@ -319,6 +324,7 @@ func GetRenderedBootstrapConfig(opts *RenderBootstrapConfigOptions) (string, err
 		}
 
 		cfg.parameters.OverloadManager.MaxHeapSizeBytes = opts.MaxHeapSizeBytes
+		cfg.parameters.LocalCluster = opts.LocalCluster
 	}
 
 	if err := cfg.render(); err != nil {
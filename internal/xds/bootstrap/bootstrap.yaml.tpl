@@ -49,6 +49,10 @@ dynamic_resources:
   cds_config:
     ads: {}
     resource_api_version: V3
+{{- if .LocalCluster }}
+cluster_manager:
+  local_cluster_name: local_cluster
+{{- end }}
 {{- if .OtelMetricSinks }}
 stats_sinks:
 {{- range $idx, $sink := .OtelMetricSinks }}
@@ -240,6 +244,15 @@ static_resources:
               path_config_source:
                 path: {{ .SdsTrustedCAPath }}
               resource_api_version: V3
+{{- if .LocalCluster }}
+  - name: local_cluster
+    type: EDS
+    connect_timeout: 10s
+    eds_cluster_config:
+      eds_config:
+        ads: {}
+        resource_api_version: V3
+{{- end }}
 overload_manager:
   refresh_interval: 0.25s
   resource_monitors:
@@ -167,6 +167,13 @@ func TestGetRenderedBootstrapConfig(t *testing.T) {
 				IPFamily: ptr.To(egv1a1.IPv6),
 			},
 		},
+		{
+			name: "local-cluster",
+			opts: &RenderBootstrapConfigOptions{
+				LocalCluster: true,
+				SdsConfig:    sds,
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -0,0 +1,181 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"time"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	adaptiveconcurrencyv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/adaptive_concurrency/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const (
+	// defaultAdaptiveConcurrencySampleWindow is the default period of time the
+	// latencies are sampled before the concurrency limit is recalculated.
+	defaultAdaptiveConcurrencySampleWindow = 100 * time.Millisecond
+	// defaultAdaptiveConcurrencyMinRTTInterval is the default time between two
+	// minRTT recalculations.
+	defaultAdaptiveConcurrencyMinRTTInterval = 60 * time.Second
+)
+
+func init() {
+	registerHTTPFilter(&adaptiveConcurrency{})
+}
+
+type adaptiveConcurrency struct{}
+
+var _ httpFilter = &adaptiveConcurrency{}
+
+// patchHCM builds and appends the adaptive concurrency Filters to the HTTP Connection
+// Manager if applicable.
+// Note: Envoy doesn't support per-route adaptive concurrency config, so this method
+// creates an adaptive concurrency filter for each route that contains an
+// AdaptiveConcurrency config, which also gives each route its own concurrency limit.
+// The filter is disabled by default. It is enabled on the route level.
+func (*adaptiveConcurrency) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		ac := routeAdaptiveConcurrency(route)
+		if ac == nil || hcmContainsFilter(mgr, adaptiveConcurrencyFilterName(route)) {
+			continue
+		}
+
+		filter, err := buildHCMAdaptiveConcurrencyFilter(route, ac)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// routeAdaptiveConcurrency returns the adaptive concurrency config of the provided
+// route, if any.
+func routeAdaptiveConcurrency(irRoute *ir.HTTPRoute) *ir.AdaptiveConcurrency {
+	if irRoute != nil && irRoute.Traffic != nil {
+		return irRoute.Traffic.AdaptiveConcurrency
+	}
+	return nil
+}
+
+// buildHCMAdaptiveConcurrencyFilter returns an adaptive concurrency HTTP filter
+// with a gradient controller from the provided IR AdaptiveConcurrency.
+func buildHCMAdaptiveConcurrencyFilter(irRoute *ir.HTTPRoute, ac *ir.AdaptiveConcurrency) (*hcmv3.HttpFilter, error) {
+	sampleWindow := defaultAdaptiveConcurrencySampleWindow
+	if ac.SampleWindow != nil {
+		sampleWindow = ac.SampleWindow.Duration
+	}
+
+	gradientController := &adaptiveconcurrencyv3.GradientControllerConfig{
+		ConcurrencyLimitParams: &adaptiveconcurrencyv3.GradientControllerConfig_ConcurrencyLimitCalculationParams{
+			ConcurrencyUpdateInterval: durationpb.New(sampleWindow),
+		},
+		MinRttCalcParams: buildAdaptiveConcurrencyMinRTTParams(ac.MinRTT),
+	}
+	if ac.SampleAggregatePercentile != nil {
+		gradientController.SampleAggregatePercentile = adaptiveConcurrencyPercent(*ac.SampleAggregatePercentile)
+	}
+	if ac.MaxConcurrencyLimit != nil {
+		gradientController.ConcurrencyLimitParams.MaxConcurrencyLimit = wrapperspb.UInt32(*ac.MaxConcurrencyLimit)
+	}
+
+	acProto := &adaptiveconcurrencyv3.AdaptiveConcurrency{
+		ConcurrencyControllerConfig: &adaptiveconcurrencyv3.AdaptiveConcurrency_GradientControllerConfig{
+			GradientControllerConfig: gradientController,
+		},
+	}
+
+	acAny, err := protocov.ToAnyWithValidation(acProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     adaptiveConcurrencyFilterName(irRoute),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: acAny,
+		},
+	}, nil
+}
+
+func buildAdaptiveConcurrencyMinRTTParams(minRTT *ir.AdaptiveConcurrencyMinRTT) *adaptiveconcurrencyv3.GradientControllerConfig_MinimumRTTCalculationParams {
+	params := &adaptiveconcurrencyv3.GradientControllerConfig_MinimumRTTCalculationParams{}
+	if minRTT == nil {
+		params.Interval = durationpb.New(defaultAdaptiveConcurrencyMinRTTInterval)
+		return params
+	}
+
+	switch {
+	case minRTT.FixedValue != nil:
+		params.FixedValue = durationpb.New(minRTT.FixedValue.Duration)
+	case minRTT.Interval != nil:
+		params.Interval = durationpb.New(minRTT.Interval.Duration)
+	default:
+		params.Interval = durationpb.New(defaultAdaptiveConcurrencyMinRTTInterval)
+	}
+	if minRTT.RequestCount != nil {
+		params.RequestCount = wrapperspb.UInt32(*minRTT.RequestCount)
+	}
+	if minRTT.Jitter != nil {
+		params.Jitter = adaptiveConcurrencyPercent(*minRTT.Jitter)
+	}
+	if minRTT.MinConcurrency != nil {
+		params.MinConcurrency = wrapperspb.UInt32(*minRTT.MinConcurrency)
+	}
+	if minRTT.Buffer != nil {
+		params.Buffer = adaptiveConcurrencyPercent(*minRTT.Buffer)
+	}
+	return params
+}
+
+func adaptiveConcurrencyPercent(percent uint32) *typev3.Percent {
+	return &typev3.Percent{Value: float64(percent)}
+}
+
+func adaptiveConcurrencyFilterName(irRoute *ir.HTTPRoute) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterAdaptiveConcurrency, irRoute.Name)
+}
+
+func (*adaptiveConcurrency) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the adaptive concurrency config if applicable.
+// Note: this method enables the corresponding adaptive concurrency filter for the provided route.
+func (*adaptiveConcurrency) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if routeAdaptiveConcurrency(irRoute) == nil {
+		return nil
+	}
+	return enableFilterOnRoute(route, adaptiveConcurrencyFilterName(irRoute))
+}
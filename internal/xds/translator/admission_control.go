@@ -0,0 +1,158 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	admissioncontrolv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/admission_control/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&admissionControl{})
+}
+
+type admissionControl struct{}
+
+var _ httpFilter = &admissionControl{}
+
+// patchHCM builds and appends the admission control Filters to the HTTP Connection
+// Manager if applicable.
+// Note: Envoy doesn't support per-route admission control config, so this method
+// creates an admission control filter for each route that contains an
+// AdmissionControl config, which also tracks the success rate of each route separately.
+// The filter is disabled by default. It is enabled on the route level.
+func (*admissionControl) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		adc := routeAdmissionControl(route)
+		if adc == nil || hcmContainsFilter(mgr, admissionControlFilterName(route)) {
+			continue
+		}
+
+		filter, err := buildHCMAdmissionControlFilter(route, adc)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// routeAdmissionControl returns the admission control config of the provided
+// route, if any.
+func routeAdmissionControl(irRoute *ir.HTTPRoute) *ir.AdmissionControl {
+	if irRoute != nil && irRoute.Traffic != nil {
+		return irRoute.Traffic.AdmissionControl
+	}
+	return nil
+}
+
+// buildHCMAdmissionControlFilter returns an admission control HTTP filter from the
+// provided IR AdmissionControl.
+func buildHCMAdmissionControlFilter(irRoute *ir.HTTPRoute, adc *ir.AdmissionControl) (*hcmv3.HttpFilter, error) {
+	successCriteria := &admissioncontrolv3.AdmissionControl_SuccessCriteria{}
+	if len(adc.HTTPSuccessStatuses) > 0 {
+		httpCriteria := &admissioncontrolv3.AdmissionControl_SuccessCriteria_HttpCriteria{}
+		for _, r := range adc.HTTPSuccessStatuses {
+			// The Envoy range is exclusive of the end value.
+			httpCriteria.HttpSuccessStatus = append(httpCriteria.HttpSuccessStatus, &typev3.Int32Range{
+				Start: int32(r.Start),
+				End:   int32(r.End) + 1,
+			})
+		}
+		successCriteria.HttpCriteria = httpCriteria
+	}
+	if len(adc.GRPCSuccessStatuses) > 0 {
+		successCriteria.GrpcCriteria = &admissioncontrolv3.AdmissionControl_SuccessCriteria_GrpcCriteria{
+			GrpcSuccessStatus: adc.GRPCSuccessStatuses,
+		}
+	}
+
+	adcProto := &admissioncontrolv3.AdmissionControl{
+		EvaluationCriteria: &admissioncontrolv3.AdmissionControl_SuccessCriteria_{
+			SuccessCriteria: successCriteria,
+		},
+	}
+	if adc.SamplingWindow != nil {
+		adcProto.SamplingWindow = durationpb.New(adc.SamplingWindow.Duration)
+	}
+	if adc.SuccessRateThreshold != nil {
+		adcProto.SrThreshold = admissionControlRuntimePercent(*adc.SuccessRateThreshold)
+	}
+	if adc.Aggression != nil {
+		adcProto.Aggression = &corev3.RuntimeDouble{DefaultValue: float64(*adc.Aggression)}
+	}
+	if adc.MinRPS != nil {
+		adcProto.RpsThreshold = &corev3.RuntimeUInt32{DefaultValue: *adc.MinRPS}
+	}
+	if adc.MaxRejectionProbability != nil {
+		adcProto.MaxRejectionProbability = admissionControlRuntimePercent(*adc.MaxRejectionProbability)
+	}
+
+	adcAny, err := protocov.ToAnyWithValidation(adcProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     admissionControlFilterName(irRoute),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: adcAny,
+		},
+	}, nil
+}
+
+func admissionControlRuntimePercent(percent uint32) *corev3.RuntimePercent {
+	return &corev3.RuntimePercent{
+		DefaultValue: &typev3.Percent{Value: float64(percent)},
+	}
+}
+
+func admissionControlFilterName(irRoute *ir.HTTPRoute) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterAdmissionControl, irRoute.Name)
+}
+
+func (*admissionControl) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the admission control config if applicable.
+// Note: this method enables the corresponding admission control filter for the provided route.
+func (*admissionControl) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if routeAdmissionControl(irRoute) == nil {
+		return nil
+	}
+	return enableFilterOnRoute(route, admissionControlFilterName(irRoute))
+}
@@ -18,9 +18,11 @@ import (
 	rbacv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/rbac/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	networkinput "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/network/v3"
+	sslinput "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/common_inputs/ssl/v3"
 	ipmatcherv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/ip/v3"
 	metadatav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/matching/input_matchers/metadata/v3"
 	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"google.golang.org/protobuf/types/known/wrapperspb"
 
@@ -179,9 +181,12 @@ func buildRBACPerRoute(authorization *ir.Authorization) (*rbacv3.RBACPerRoute, e
 	// If no matcher matches, the default action will be used.
 	for _, rule := range authorization.Rules {
 		var (
-			ipPredicate  *matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_
-			jwtPredicate []*matcherv3.Matcher_MatcherList_Predicate
-			predicate    *matcherv3.Matcher_MatcherList_Predicate
+			ipPredicate        *matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_
+			jwtPredicate       []*matcherv3.Matcher_MatcherList_Predicate
+			certPredicate      []*matcherv3.Matcher_MatcherList_Predicate
+			operationPredicate []*matcherv3.Matcher_MatcherList_Predicate
+			predicates         []*matcherv3.Matcher_MatcherList_Predicate
+			predicate          *matcherv3.Matcher_MatcherList_Predicate
 		)
 
 		// Determine the action for the current rule.
@@ -194,25 +199,35 @@ func buildRBACPerRoute(authorization *ir.Authorization) (*rbacv3.RBACPerRoute, e
 			if ipPredicate, err = buildIPPredicate(rule.Principal.ClientCIDRs); err != nil {
 				return nil, err
 			}
+			predicates = append(predicates, &matcherv3.Matcher_MatcherList_Predicate{
+				MatchType: ipPredicate,
+			})
 		}
 
 		if rule.Principal.JWT != nil {
 			if jwtPredicate, err = buildJWTPredicate(*rule.Principal.JWT); err != nil {
 				return nil, err
 			}
+			predicates = append(predicates, jwtPredicate...)
 		}
 
-		// Build the predicate for the current rule.
-		switch {
-		// If both IP and JWT predicates are present, AND them together.
-		case ipPredicate != nil && jwtPredicate != nil:
-			predicates := []*matcherv3.Matcher_MatcherList_Predicate{
-				{
-					MatchType: ipPredicate,
-				},
+		if rule.Principal.ClientCertificate != nil {
+			if certPredicate, err = buildClientCertificatePredicate(rule.Principal.ClientCertificate); err != nil {
+				return nil, err
 			}
-			predicates = append(predicates, jwtPredicate...)
+			predicates = append(predicates, certPredicate...)
+		}
+
+		if rule.Operation != nil {
+			if operationPredicate, err = buildOperationPredicate(rule.Operation); err != nil {
+				return nil, err
+			}
+			predicates = append(predicates, operationPredicate...)
+		}
 
+		// Build the predicate for the current rule.
+		// If there are multiple predicates, AND them together.
+		if len(predicates) > 1 {
 			predicate = &matcherv3.Matcher_MatcherList_Predicate{
 				MatchType: &matcherv3.Matcher_MatcherList_Predicate_AndMatcher{
 					AndMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
@@ -220,23 +235,8 @@ func buildRBACPerRoute(authorization *ir.Authorization) (*rbacv3.RBACPerRoute, e
 					},
 				},
 			}
-		case ipPredicate != nil:
-			predicate = &matcherv3.Matcher_MatcherList_Predicate{
-				MatchType: ipPredicate,
-			}
-		case jwtPredicate != nil:
-			// If there are multiple JWT predicates, AND them together.
-			if len(jwtPredicate) > 1 {
-				predicate = &matcherv3.Matcher_MatcherList_Predicate{
-					MatchType: &matcherv3.Matcher_MatcherList_Predicate_AndMatcher{
-						AndMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
-							Predicate: jwtPredicate,
-						},
-					},
-				}
-			} else if len(jwtPredicate) == 1 {
-				predicate = jwtPredicate[0]
-			}
+		} else if len(predicates) == 1 {
+			predicate = predicates[0]
 		}
 
 		// Add the matcher generated with the current rule to the matcher list.
@@ -539,3 +539,228 @@ func buildJWTPredicate(jwt egv1a1.JWTPrincipal) ([]*matcherv3.Matcher_MatcherLis
 func (c *rbac) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
 	return nil
 }
+
+// buildClientCertificatePredicate builds the predicates for the client certificate
+// principal of an authorization rule.
+// Different types of identities are ANDed together, and multiple values of the same
+// type are ORed together.
+func buildClientCertificatePredicate(clientCert *ir.ClientCertificatePrincipal) ([]*matcherv3.Matcher_MatcherList_Predicate, error) {
+	var (
+		certPredicate []*matcherv3.Matcher_MatcherList_Predicate
+		predicates    []*matcherv3.Matcher_MatcherList_Predicate
+		predicate     *matcherv3.Matcher_MatcherList_Predicate
+		err           error
+	)
+
+	// Multiple URI SANs are ORed together.
+	for _, san := range clientCert.URISANs {
+		if predicate, err = buildInputPredicate("uri_san", &sslinput.UriSanInput{}, buildRBACStringMatcher(san)); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+	certPredicate = appendOrPredicate(certPredicate, predicates)
+
+	// Multiple DNS SANs are ORed together.
+	predicates = nil
+	for _, san := range clientCert.DNSSANs {
+		if predicate, err = buildInputPredicate("dns_san", &sslinput.DnsSanInput{}, buildRBACStringMatcher(san)); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+	certPredicate = appendOrPredicate(certPredicate, predicates)
+
+	// Multiple subjects are ORed together.
+	predicates = nil
+	for _, subject := range clientCert.Subjects {
+		if predicate, err = buildInputPredicate("subject", &sslinput.SubjectInput{}, buildRBACStringMatcher(subject)); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+	certPredicate = appendOrPredicate(certPredicate, predicates)
+
+	return certPredicate, nil
+}
+
+// buildOperationPredicate builds the predicates for the request attributes of an
+// authorization rule.
+// Different types of attributes are ANDed together, and multiple values of the same
+// type are ORed together, except for headers, which are ANDed together.
+func buildOperationPredicate(operation *ir.Operation) ([]*matcherv3.Matcher_MatcherList_Predicate, error) {
+	var (
+		operationPredicate []*matcherv3.Matcher_MatcherList_Predicate
+		predicates         []*matcherv3.Matcher_MatcherList_Predicate
+		predicate          *matcherv3.Matcher_MatcherList_Predicate
+		err                error
+	)
+
+	// Multiple methods are ORed together.
+	for _, method := range operation.Methods {
+		if predicate, err = buildRequestHeaderPredicate(":method", &matcherv3.StringMatcher{
+			MatchPattern: &matcherv3.StringMatcher_Exact{
+				Exact: method,
+			},
+		}); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+	operationPredicate = appendOrPredicate(operationPredicate, predicates)
+
+	// Multiple paths are ORed together.
+	predicates = nil
+	for _, path := range operation.Paths {
+		if predicate, err = buildRequestHeaderPredicate(":path", buildPathStringMatcher(path)); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+	operationPredicate = appendOrPredicate(operationPredicate, predicates)
+
+	// Multiple headers are ANDed together.
+	for _, header := range operation.Headers {
+		if predicate, err = buildRequestHeaderPredicate(header.Name, buildRBACStringMatcher(header)); err != nil {
+			return nil, err
+		}
+		operationPredicate = append(operationPredicate, predicate)
+	}
+
+	// Multiple hosts are ORed together.
+	predicates = nil
+	for _, host := range operation.Hosts {
+		if predicate, err = buildRequestHeaderPredicate(":authority", buildRBACStringMatcher(host)); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+	operationPredicate = appendOrPredicate(operationPredicate, predicates)
+
+	// Multiple server names are ORed together.
+	predicates = nil
+	for _, serverName := range operation.ServerNames {
+		if predicate, err = buildServerNamePredicate(buildRBACStringMatcher(serverName)); err != nil {
+			return nil, err
+		}
+		predicates = append(predicates, predicate)
+	}
+	operationPredicate = appendOrPredicate(operationPredicate, predicates)
+
+	return operationPredicate, nil
+}
+
+// appendOrPredicate ORs the provided predicates together and appends the result
+// to the predicate list.
+func appendOrPredicate(
+	predicateList []*matcherv3.Matcher_MatcherList_Predicate,
+	predicates []*matcherv3.Matcher_MatcherList_Predicate,
+) []*matcherv3.Matcher_MatcherList_Predicate {
+	switch {
+	case len(predicates) > 1:
+		return append(predicateList, &matcherv3.Matcher_MatcherList_Predicate{
+			MatchType: &matcherv3.Matcher_MatcherList_Predicate_OrMatcher{
+				OrMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+					Predicate: predicates,
+				},
+			},
+		})
+	case len(predicates) == 1:
+		return append(predicateList, predicates[0])
+	default:
+		return predicateList
+	}
+}
+
+func buildRequestHeaderPredicate(
+	headerName string,
+	stringMatcher *matcherv3.StringMatcher,
+) (*matcherv3.Matcher_MatcherList_Predicate, error) {
+	return buildInputPredicate("request_header", &envoymatcherv3.HttpRequestHeaderMatchInput{
+		HeaderName: headerName,
+	}, stringMatcher)
+}
+
+func buildServerNamePredicate(stringMatcher *matcherv3.StringMatcher) (*matcherv3.Matcher_MatcherList_Predicate, error) {
+	return buildInputPredicate("server_name", &networkinput.ServerNameInput{}, stringMatcher)
+}
+
+// buildInputPredicate builds a single predicate that matches the value of the
+// provided input with the string matcher.
+func buildInputPredicate(
+	inputName string,
+	input proto.Message,
+	stringMatcher *matcherv3.StringMatcher,
+) (*matcherv3.Matcher_MatcherList_Predicate, error) {
+	inputPb, err := protocov.ToAnyWithValidation(input)
+	if err != nil {
+		return nil, err
+	}
+
+	return &matcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+			SinglePredicate: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+				Input: &cncfv3.TypedExtensionConfig{
+					Name:        inputName,
+					TypedConfig: inputPb,
+				},
+				Matcher: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+					ValueMatch: stringMatcher,
+				},
+			},
+		},
+	}, nil
+}
+
+// buildRBACStringMatcher converts an IR StringMatch into a StringMatcher of the
+// generic matching API.
+func buildRBACStringMatcher(irMatch *ir.StringMatch) *matcherv3.StringMatcher {
+	switch {
+	case irMatch.Exact != nil:
+		return &matcherv3.StringMatcher{
+			MatchPattern: &matcherv3.StringMatcher_Exact{
+				Exact: *irMatch.Exact,
+			},
+		}
+	case irMatch.Prefix != nil:
+		return &matcherv3.StringMatcher{
+			MatchPattern: &matcherv3.StringMatcher_Prefix{
+				Prefix: *irMatch.Prefix,
+			},
+		}
+	case irMatch.Suffix != nil:
+		return &matcherv3.StringMatcher{
+			MatchPattern: &matcherv3.StringMatcher_Suffix{
+				Suffix: *irMatch.Suffix,
+			},
+		}
+	case irMatch.SafeRegex != nil:
+		return buildRBACRegexMatcher(*irMatch.SafeRegex)
+	default:
+		return &matcherv3.StringMatcher{}
+	}
+}
+
+// buildPathStringMatcher converts an IR StringMatch into a StringMatcher for the
+// :path header.
+// The :path header contains the query string, so exact, suffix and regex matches
+// are converted into regexes that ignore the query string.
+func buildPathStringMatcher(irMatch *ir.StringMatch) *matcherv3.StringMatcher {
+	if irMatch.Exact == nil && irMatch.Suffix == nil && irMatch.SafeRegex == nil {
+		return buildRBACStringMatcher(irMatch)
+	}
+	return buildRBACRegexMatcher(pathRegex(irMatch))
+}
+
+func buildRBACRegexMatcher(regex string) *matcherv3.StringMatcher {
+	return &matcherv3.StringMatcher{
+		MatchPattern: &matcherv3.StringMatcher_SafeRegex{
+			SafeRegex: &matcherv3.RegexMatcher{
+				Regex: regex,
+				EngineType: &matcherv3.RegexMatcher_GoogleRe2{
+					GoogleRe2: &matcherv3.RegexMatcher_GoogleRE2{},
+				},
+			},
+		},
+	}
+}
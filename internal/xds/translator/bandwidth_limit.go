@@ -0,0 +1,153 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	bandwidthlimitv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/bandwidth_limit/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+const bandwidthLimitFilterStatPrefix = "http_bandwidth_limit"
+
+func init() {
+	registerHTTPFilter(&bandwidthLimit{})
+}
+
+type bandwidthLimit struct{}
+
+var _ httpFilter = &bandwidthLimit{}
+
+// patchHCM builds and appends the bandwidth limit filter to the HTTP Connection
+// Manager if applicable, and it does not already exist.
+// Note: the filter is disabled by default. It is enabled on the route level, with
+// the bandwidth limit of the route.
+func (*bandwidthLimit) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+	if !listenerContainsBandwidthLimit(irListener) {
+		return nil
+	}
+	if hcmContainsFilter(mgr, egv1a1.EnvoyFilterBandwidthLimit.String()) {
+		return nil
+	}
+
+	// The bandwidth limit filter at the HTTP connection manager level is an
+	// empty filter. The real configuration is done at the route level.
+	bandwidthLimitAny, err := protocov.ToAnyWithValidation(&bandwidthlimitv3.BandwidthLimit{
+		StatPrefix: bandwidthLimitFilterStatPrefix,
+	})
+	if err != nil {
+		return err
+	}
+
+	mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+		Name:     egv1a1.EnvoyFilterBandwidthLimit.String(),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: bandwidthLimitAny,
+		},
+	})
+	return nil
+}
+
+func listenerContainsBandwidthLimit(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if routeContainsBandwidthLimit(route) {
+			return true
+		}
+	}
+	return false
+}
+
+func routeContainsBandwidthLimit(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.Traffic != nil && irRoute.Traffic.BandwidthLimit != nil
+}
+
+func (*bandwidthLimit) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the bandwidth limit config if applicable.
+// Note: this method enables the bandwidth limit filter for the provided route, with
+// the bandwidth limit of the route.
+func (*bandwidthLimit) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsBandwidthLimit(irRoute) {
+		return nil
+	}
+
+	filterName := egv1a1.EnvoyFilterBandwidthLimit.String()
+	filterCfg := route.GetTypedPerFilterConfig()
+	if _, ok := filterCfg[filterName]; ok {
+		// This should not happen since this is the only place where the filter
+		// config is added in a route.
+		return fmt.Errorf("route already contains filter config: %s, %+v",
+			filterName, route)
+	}
+
+	perRouteAny, err := protocov.ToAnyWithValidation(buildBandwidthLimit(irRoute.Traffic.BandwidthLimit))
+	if err != nil {
+		return err
+	}
+
+	// Enable the filter for this route with the per-route config.
+	routeCfgAny, err := anypb.New(&routev3.FilterConfig{
+		Config: perRouteAny,
+	})
+	if err != nil {
+		return err
+	}
+
+	if filterCfg == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[filterName] = routeCfgAny
+
+	return nil
+}
+
+func buildBandwidthLimit(bl *ir.BandwidthLimit) *bandwidthlimitv3.BandwidthLimit {
+	bandwidthLimitProto := &bandwidthlimitv3.BandwidthLimit{
+		StatPrefix: bandwidthLimitFilterStatPrefix,
+		LimitKbps:  wrapperspb.UInt64(bl.LimitKbps),
+	}
+
+	switch bl.Direction {
+	case egv1a1.BandwidthLimitDirectionRequest:
+		bandwidthLimitProto.EnableMode = bandwidthlimitv3.BandwidthLimit_REQUEST
+	case egv1a1.BandwidthLimitDirectionRequestAndResponse:
+		bandwidthLimitProto.EnableMode = bandwidthlimitv3.BandwidthLimit_REQUEST_AND_RESPONSE
+	default:
+		bandwidthLimitProto.EnableMode = bandwidthlimitv3.BandwidthLimit_RESPONSE
+	}
+
+	if bl.FillInterval != nil {
+		bandwidthLimitProto.FillInterval = durationpb.New(bl.FillInterval.Duration)
+	}
+
+	return bandwidthLimitProto
+}
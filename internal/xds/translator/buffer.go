@@ -0,0 +1,135 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	bufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&buffer{})
+}
+
+type buffer struct{}
+
+var _ httpFilter = &buffer{}
+
+// patchHCM builds and appends the buffer filter to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: the filter is disabled by default. It is enabled on the route level, with
+// the per-route limit of the request body size.
+func (*buffer) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+	if !listenerContainsRequestBuffer(irListener) {
+		return nil
+	}
+	if hcmContainsFilter(mgr, egv1a1.EnvoyFilterBuffer.String()) {
+		return nil
+	}
+
+	// The limit at the HTTP connection manager level is never used, since the
+	// filter is only enabled by the routes, which override it.
+	bufferAny, err := protocov.ToAnyWithValidation(&bufferv3.Buffer{
+		MaxRequestBytes: wrapperspb.UInt32(math.MaxUint32),
+	})
+	if err != nil {
+		return err
+	}
+
+	mgr.HttpFilters = append(mgr.HttpFilters, &hcmv3.HttpFilter{
+		Name:     egv1a1.EnvoyFilterBuffer.String(),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: bufferAny,
+		},
+	})
+	return nil
+}
+
+func listenerContainsRequestBuffer(irListener *ir.HTTPListener) bool {
+	for _, route := range irListener.Routes {
+		if routeContainsRequestBuffer(route) {
+			return true
+		}
+	}
+	return false
+}
+
+func routeContainsRequestBuffer(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.Traffic != nil && irRoute.Traffic.RequestBuffer != nil
+}
+
+func (*buffer) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the request buffer config if applicable.
+// Note: this method enables the buffer filter for the provided route, with the
+// request body size limit of the route.
+func (*buffer) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsRequestBuffer(irRoute) {
+		return nil
+	}
+
+	filterName := egv1a1.EnvoyFilterBuffer.String()
+	filterCfg := route.GetTypedPerFilterConfig()
+	if _, ok := filterCfg[filterName]; ok {
+		// This should not happen since this is the only place where the filter
+		// config is added in a route.
+		return fmt.Errorf("route already contains filter config: %s, %+v",
+			filterName, route)
+	}
+
+	perRouteAny, err := protocov.ToAnyWithValidation(&bufferv3.BufferPerRoute{
+		Override: &bufferv3.BufferPerRoute_Buffer{
+			Buffer: &bufferv3.Buffer{
+				MaxRequestBytes: wrapperspb.UInt32(irRoute.Traffic.RequestBuffer.LimitBytes),
+			},
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Enable the filter for this route with the per-route config.
+	routeCfgAny, err := anypb.New(&routev3.FilterConfig{
+		Config: perRouteAny,
+	})
+	if err != nil {
+		return err
+	}
+
+	if filterCfg == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[filterName] = routeCfgAny
+
+	return nil
+}
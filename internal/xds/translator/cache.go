@@ -0,0 +1,438 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	cncfv3 "github.com/cncf/xds/go/xds/core/v3"
+	matcherv3 "github.com/cncf/xds/go/xds/type/matcher/v3"
+	mutationrulesv3 "github.com/envoyproxy/go-control-plane/envoy/config/common/mutation_rules/v3"
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	asyncfilesv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/async_files/v3"
+	matchingv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/common/matching/v3"
+	actionv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/common/matcher/action/v3"
+	cachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/cache/v3"
+	headermutationv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/header_mutation/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	filecachev3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/cache/file_system_http_cache/v3"
+	envoymatcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+// The TTL of a Cache config is applied by a header mutation filter, which sets
+// the Cache-Control header of the responses before the cache filter processes
+// them. The filter is shared by the routes with the same TTL, and it's placed
+// right after the cache filters, see newOrderedHTTPFilter.
+const (
+	cacheTTLFilterType = "envoy.filters.http.header_mutation"
+	cacheTTLConfigName = "cache_ttl"
+)
+
+const (
+	// cacheFileManagerID is the ID of the async file manager shared by the stores of
+	// all the cache filters.
+	cacheFileManagerID = "envoy-gateway-cache"
+	// cacheBasePath is the directory of the stores of the cache filters. Each cache
+	// filter has its own store in a sub-directory named after the hash of the route.
+	cacheBasePath = "/tmp/envoy-gateway/cache"
+	// defaultCacheMaxSizeBytes is the maximum size of a store if not specified.
+	defaultCacheMaxSizeBytes = 64 * 1024 * 1024
+)
+
+// cacheTTLSkipCacheControlRegex matches the Cache-Control directives that make a
+// response uncacheable by a shared cache. The TTL isn't applied to such responses.
+const cacheTTLSkipCacheControlRegex = `(?i)(^|.*[\s,])(private|no-store)([\s,=].*|$)`
+
+func init() {
+	registerHTTPFilter(&cache{})
+}
+
+type cache struct{}
+
+var _ httpFilter = &cache{}
+
+// patchHCM builds and appends the cache Filters to the HTTP Connection Manager
+// if applicable.
+// Note: Envoy doesn't support per-route cache config, so this method creates a
+// cache filter for each route that contains a Cache config, and a TTL filter for
+// each TTL.
+// The filters are disabled by default. They are enabled on the route level.
+// Each cache filter has its own store, bounded by the MaxSizeBytes of the route.
+func (*cache) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		if !routeContainsCache(route) {
+			continue
+		}
+
+		if !hcmContainsFilter(mgr, cacheFilterName(route)) {
+			filter, err := buildHCMCacheFilter(route)
+			if err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				mgr.HttpFilters = append(mgr.HttpFilters, filter)
+			}
+		}
+
+		ttl := route.Traffic.Cache.TTL
+		if ttl != nil && !hcmContainsFilter(mgr, cacheTTLFilterName(ttl.Seconds())) {
+			filter, err := buildHCMCacheTTLFilter(ttl.Seconds())
+			if err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				mgr.HttpFilters = append(mgr.HttpFilters, filter)
+			}
+		}
+	}
+
+	return errs
+}
+
+// routeContainsCache returns true if Cache exists for the provided route.
+func routeContainsCache(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.Traffic != nil && irRoute.Traffic.Cache != nil
+}
+
+// buildHCMCacheFilter returns a cache HTTP filter from the Cache config of the
+// provided IR route.
+// If the cacheable methods or status codes are restricted, the cache filter is
+// wrapped in a matcher that skips it for the other requests and responses.
+func buildHCMCacheFilter(irRoute *ir.HTTPRoute) (*hcmv3.HttpFilter, error) {
+	c := irRoute.Traffic.Cache
+
+	maxSizeBytes := uint64(defaultCacheMaxSizeBytes)
+	if c.MaxSizeBytes != nil {
+		maxSizeBytes = *c.MaxSizeBytes
+	}
+	storeAny, err := protocov.ToAnyWithValidation(&filecachev3.FileSystemHttpCacheConfig{
+		ManagerConfig: &asyncfilesv3.AsyncFileManagerConfig{
+			Id: cacheFileManagerID,
+			ManagerType: &asyncfilesv3.AsyncFileManagerConfig_ThreadPool_{
+				ThreadPool: &asyncfilesv3.AsyncFileManagerConfig_ThreadPool{},
+			},
+		},
+		CachePath:         path.Join(cacheBasePath, utils.Digest256(irRoute.Name)),
+		CreateCachePath:   true,
+		MaxCacheSizeBytes: wrapperspb.UInt64(maxSizeBytes),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cacheProto := &cachev3.CacheConfig{
+		TypedConfig: storeAny,
+	}
+	for _, h := range c.VaryHeaders {
+		cacheProto.AllowedVaryHeaders = append(cacheProto.AllowedVaryHeaders, &envoymatcherv3.StringMatcher{
+			MatchPattern: &envoymatcherv3.StringMatcher_Exact{
+				Exact: h,
+			},
+			IgnoreCase: true,
+		})
+	}
+	if c.MaxBodyBytes != nil {
+		cacheProto.MaxBodyBytes = *c.MaxBodyBytes
+	}
+
+	cacheAny, err := protocov.ToAnyWithValidation(cacheProto)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.Methods) > 0 || len(c.StatusCodes) > 0 {
+		if cacheAny, err = buildCacheWithMatcher(c, cacheAny); err != nil {
+			return nil, err
+		}
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     cacheFilterName(irRoute),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: cacheAny,
+		},
+	}, nil
+}
+
+// buildCacheWithMatcher wraps the provided cache filter config in a matcher that
+// skips the cache filter for the requests with a method that isn't cacheable,
+// and the responses with a status code that isn't cacheable.
+func buildCacheWithMatcher(c *ir.Cache, cacheAny *anypb.Any) (*anypb.Any, error) {
+	var matchers []*matcherv3.Matcher_MatcherList_FieldMatcher
+
+	skipAny, err := protocov.ToAnyWithValidation(&actionv3.SkipFilter{})
+	if err != nil {
+		return nil, err
+	}
+	onMatchSkip := &matcherv3.Matcher_OnMatch{
+		OnMatch: &matcherv3.Matcher_OnMatch_Action{
+			Action: &cncfv3.TypedExtensionConfig{
+				Name:        "skip",
+				TypedConfig: skipAny,
+			},
+		},
+	}
+
+	if len(c.Methods) > 0 {
+		methodInput, err := protocov.ToAnyWithValidation(&envoymatcherv3.HttpRequestHeaderMatchInput{
+			HeaderName: ":method",
+		})
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, &matcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: buildNotInPredicate(&cncfv3.TypedExtensionConfig{
+				Name:        "request-method",
+				TypedConfig: methodInput,
+			}, c.Methods),
+			OnMatch: onMatchSkip,
+		})
+	}
+
+	if len(c.StatusCodes) > 0 {
+		statusInput, err := protocov.ToAnyWithValidation(&envoymatcherv3.HttpResponseStatusCodeMatchInput{})
+		if err != nil {
+			return nil, err
+		}
+		statusCodes := make([]string, 0, len(c.StatusCodes))
+		for _, s := range c.StatusCodes {
+			statusCodes = append(statusCodes, strconv.Itoa(int(s)))
+		}
+		matchers = append(matchers, &matcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: buildNotInPredicate(&cncfv3.TypedExtensionConfig{
+				Name:        "response-status-code",
+				TypedConfig: statusInput,
+			}, statusCodes),
+			OnMatch: onMatchSkip,
+		})
+	}
+
+	return protocov.ToAnyWithValidation(&matchingv3.ExtensionWithMatcher{
+		XdsMatcher: &matcherv3.Matcher{
+			MatcherType: &matcherv3.Matcher_MatcherList_{
+				MatcherList: &matcherv3.Matcher_MatcherList{
+					Matchers: matchers,
+				},
+			},
+		},
+		ExtensionConfig: &corev3.TypedExtensionConfig{
+			Name:        string(egv1a1.EnvoyFilterCache),
+			TypedConfig: cacheAny,
+		},
+	})
+}
+
+// buildNotInPredicate returns a predicate that matches when the value of the
+// provided input is none of the provided values.
+func buildNotInPredicate(input *cncfv3.TypedExtensionConfig, values []string) *matcherv3.Matcher_MatcherList_Predicate {
+	predicates := make([]*matcherv3.Matcher_MatcherList_Predicate, 0, len(values))
+	for _, v := range values {
+		predicates = append(predicates, &matcherv3.Matcher_MatcherList_Predicate{
+			MatchType: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+				SinglePredicate: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+					Input: input,
+					Matcher: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+						ValueMatch: &matcherv3.StringMatcher{
+							MatchPattern: &matcherv3.StringMatcher_Exact{
+								Exact: v,
+							},
+						},
+					},
+				},
+			},
+		})
+	}
+
+	// The OR matcher requires at least two predicates.
+	predicate := predicates[0]
+	if len(predicates) > 1 {
+		predicate = &matcherv3.Matcher_MatcherList_Predicate{
+			MatchType: &matcherv3.Matcher_MatcherList_Predicate_OrMatcher{
+				OrMatcher: &matcherv3.Matcher_MatcherList_Predicate_PredicateList{
+					Predicate: predicates,
+				},
+			},
+		}
+	}
+
+	return &matcherv3.Matcher_MatcherList_Predicate{
+		MatchType: &matcherv3.Matcher_MatcherList_Predicate_NotMatcher{
+			NotMatcher: predicate,
+		},
+	}
+}
+
+// buildHCMCacheTTLFilter returns a header mutation HTTP filter that replaces the
+// Cache-Control header of the responses with the provided TTL.
+// The filter is wrapped in a matcher that skips it for the responses that must
+// not be stored by a shared cache: the responses with a private or no-store
+// Cache-Control directive, and the responses that set cookies.
+func buildHCMCacheTTLFilter(ttlSeconds float64) (*hcmv3.HttpFilter, error) {
+	mutationAny, err := protocov.ToAnyWithValidation(&headermutationv3.HeaderMutation{
+		Mutations: &headermutationv3.Mutations{
+			ResponseMutations: []*mutationrulesv3.HeaderMutation{
+				{
+					Action: &mutationrulesv3.HeaderMutation_Append{
+						Append: &corev3.HeaderValueOption{
+							Header: &corev3.HeaderValue{
+								Key:   "cache-control",
+								Value: fmt.Sprintf("max-age=%d", int64(ttlSeconds)),
+							},
+							AppendAction: corev3.HeaderValueOption_OVERWRITE_IF_EXISTS_OR_ADD,
+						},
+					},
+				},
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	skipAny, err := protocov.ToAnyWithValidation(&actionv3.SkipFilter{})
+	if err != nil {
+		return nil, err
+	}
+	onMatchSkip := &matcherv3.Matcher_OnMatch{
+		OnMatch: &matcherv3.Matcher_OnMatch_Action{
+			Action: &cncfv3.TypedExtensionConfig{
+				Name:        "skip",
+				TypedConfig: skipAny,
+			},
+		},
+	}
+
+	var matchers []*matcherv3.Matcher_MatcherList_FieldMatcher
+	for _, skip := range []struct {
+		header string
+		regex  string
+	}{
+		{header: "cache-control", regex: cacheTTLSkipCacheControlRegex},
+		{header: "set-cookie", regex: ".*"},
+	} {
+		headerInput, err := protocov.ToAnyWithValidation(&envoymatcherv3.HttpResponseHeaderMatchInput{
+			HeaderName: skip.header,
+		})
+		if err != nil {
+			return nil, err
+		}
+		matchers = append(matchers, &matcherv3.Matcher_MatcherList_FieldMatcher{
+			Predicate: &matcherv3.Matcher_MatcherList_Predicate{
+				MatchType: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_{
+					SinglePredicate: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate{
+						Input: &cncfv3.TypedExtensionConfig{
+							Name:        "response-" + skip.header,
+							TypedConfig: headerInput,
+						},
+						Matcher: &matcherv3.Matcher_MatcherList_Predicate_SinglePredicate_ValueMatch{
+							ValueMatch: &matcherv3.StringMatcher{
+								MatchPattern: &matcherv3.StringMatcher_SafeRegex{
+									SafeRegex: &matcherv3.RegexMatcher{
+										EngineType: &matcherv3.RegexMatcher_GoogleRe2{
+											GoogleRe2: &matcherv3.RegexMatcher_GoogleRE2{},
+										},
+										Regex: skip.regex,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			OnMatch: onMatchSkip,
+		})
+	}
+
+	filterAny, err := protocov.ToAnyWithValidation(&matchingv3.ExtensionWithMatcher{
+		XdsMatcher: &matcherv3.Matcher{
+			MatcherType: &matcherv3.Matcher_MatcherList_{
+				MatcherList: &matcherv3.Matcher_MatcherList{
+					Matchers: matchers,
+				},
+			},
+		},
+		ExtensionConfig: &corev3.TypedExtensionConfig{
+			Name:        cacheTTLFilterType,
+			TypedConfig: mutationAny,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     cacheTTLFilterName(ttlSeconds),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: filterAny,
+		},
+	}, nil
+}
+
+func cacheFilterName(irRoute *ir.HTTPRoute) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterCache, irRoute.Name)
+}
+
+// cacheTTLFilterName returns the name of the TTL filter shared by the routes
+// with the provided TTL.
+func cacheTTLFilterName(ttlSeconds float64) string {
+	return fmt.Sprintf("%s/%s/%d", cacheTTLFilterType, cacheTTLConfigName, int64(ttlSeconds))
+}
+
+// isCacheTTLFilter returns true if the provided filter is a cache TTL filter.
+func isCacheTTLFilter(filter *hcmv3.HttpFilter) bool {
+	return strings.HasPrefix(filter.Name, fmt.Sprintf("%s/%s/", cacheTTLFilterType, cacheTTLConfigName))
+}
+
+func (*cache) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the cache config if applicable.
+// Note: this method enables the corresponding cache filter for the provided route,
+// and the TTL filter if a TTL is specified.
+func (*cache) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsCache(irRoute) {
+		return nil
+	}
+
+	if err := enableFilterOnRoute(route, cacheFilterName(irRoute)); err != nil {
+		return err
+	}
+
+	if ttl := irRoute.Traffic.Cache.TTL; ttl != nil {
+		return enableFilterOnRoute(route, cacheTTLFilterName(ttl.Seconds()))
+	}
+	return nil
+}
@@ -0,0 +1,42 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCacheTTLSkipCacheControlRegex(t *testing.T) {
+	// Envoy requires the safe regex matchers to match the whole value.
+	re := regexp.MustCompile("^(?:" + cacheTTLSkipCacheControlRegex + ")$")
+
+	testCases := []struct {
+		cacheControl string
+		skip         bool
+	}{
+		{cacheControl: "private", skip: true},
+		{cacheControl: "no-store", skip: true},
+		{cacheControl: "Private", skip: true},
+		{cacheControl: "max-age=0, no-store", skip: true},
+		{cacheControl: "private, max-age=60", skip: true},
+		{cacheControl: `private="set-cookie", max-age=60`, skip: true},
+		{cacheControl: "public,no-store", skip: true},
+		{cacheControl: "public, max-age=60", skip: false},
+		{cacheControl: "no-cache", skip: false},
+		{cacheControl: "max-age=60, must-revalidate", skip: false},
+		{cacheControl: "x-private-extension", skip: false},
+		{cacheControl: "", skip: false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.cacheControl, func(t *testing.T) {
+			require.Equal(t, tc.skip, re.MatchString(tc.cacheControl))
+		})
+	}
+}
@@ -48,6 +48,7 @@ type xdsClusterArgs struct {
 	loadBalancer      *ir.LoadBalancer
 	proxyProtocol     *ir.ProxyProtocol
 	circuitBreaker    *ir.CircuitBreaker
+	retryBudget       *ir.RetryBudget
 	healthCheck       *ir.HealthCheck
 	http1Settings     *ir.HTTP1Settings
 	http2Settings     *ir.HTTP2Settings
@@ -189,6 +190,19 @@ func buildXdsCluster(args *xdsClusterArgs) *clusterv3.Cluster {
 		cluster.TypedExtensionProtocolOptions = epo
 	}
 
+	// Enable the Envoy zone-aware routing, which replaces the locality weighted load balancing.
+	if args.loadBalancer != nil && args.loadBalancer.ZoneAware != nil && args.loadBalancer.ZoneAware.PreferLocal != nil {
+		zoneAwareLbConfig := &clusterv3.Cluster_CommonLbConfig_ZoneAwareLbConfig{}
+		// Envoy compares the min_cluster_size with the number of healthy hosts of the
+		// cluster, and falls back to the locality unaware routing below it.
+		if args.loadBalancer.ZoneAware.PreferLocal.MinEndpointsThreshold != nil {
+			zoneAwareLbConfig.MinClusterSize = wrapperspb.UInt64(*args.loadBalancer.ZoneAware.PreferLocal.MinEndpointsThreshold)
+		}
+		cluster.CommonLbConfig.LocalityConfigSpecifier = &clusterv3.Cluster_CommonLbConfig_ZoneAwareLbConfig_{
+			ZoneAwareLbConfig: zoneAwareLbConfig,
+		}
+	}
+
 	// Set Load Balancer policy
 	//nolint:gocritic
 	if args.loadBalancer == nil {
@@ -239,7 +253,7 @@ func buildXdsCluster(args *xdsClusterArgs) *clusterv3.Cluster {
 		cluster.OutlierDetection = buildXdsOutlierDetection(args.healthCheck.Passive)
 	}
 
-	cluster.CircuitBreakers = buildXdsClusterCircuitBreaker(args.circuitBreaker)
+	cluster.CircuitBreakers = buildXdsClusterCircuitBreaker(args.circuitBreaker, args.retryBudget)
 
 	if args.tcpkeepalive != nil {
 		cluster.UpstreamConnectionOptions = buildXdsClusterUpstreamOptions(args.tcpkeepalive)
@@ -372,7 +386,7 @@ func buildHealthCheckPayload(irLoad *ir.HealthCheckPayload) *corev3.HealthCheck_
 	return &hcp
 }
 
-func buildXdsClusterCircuitBreaker(circuitBreaker *ir.CircuitBreaker) *clusterv3.CircuitBreakers {
+func buildXdsClusterCircuitBreaker(circuitBreaker *ir.CircuitBreaker, retryBudget *ir.RetryBudget) *clusterv3.CircuitBreakers {
 	// Always allow the same amount of retries as regular requests to handle surges in retries
 	// related to pod restarts
 	cbt := &clusterv3.CircuitBreakers_Thresholds{
@@ -408,6 +422,17 @@ func buildXdsClusterCircuitBreaker(circuitBreaker *ir.CircuitBreaker) *clusterv3
 		}
 	}
 
+	// The retry budget overrides the max retries threshold.
+	if retryBudget != nil {
+		cbt.RetryBudget = &clusterv3.CircuitBreakers_Thresholds_RetryBudget{}
+		if retryBudget.Percent != nil {
+			cbt.RetryBudget.BudgetPercent = &xdstype.Percent{Value: float64(*retryBudget.Percent)}
+		}
+		if retryBudget.MinConcurrency != nil {
+			cbt.RetryBudget.MinRetryConcurrency = wrapperspb.UInt32(*retryBudget.MinConcurrency)
+		}
+	}
+
 	ecb := &clusterv3.CircuitBreakers{
 		Thresholds: []*clusterv3.CircuitBreakers_Thresholds{cbt},
 	}
@@ -415,57 +440,53 @@ func buildXdsClusterCircuitBreaker(circuitBreaker *ir.CircuitBreaker) *clusterv3
 	return ecb
 }
 
-func buildXdsClusterLoadAssignment(clusterName string, destSettings []*ir.DestinationSetting) *endpointv3.ClusterLoadAssignment {
+func buildXdsClusterLoadAssignment(clusterName string, destSettings []*ir.DestinationSetting, zoneAware *ir.ZoneAware) *endpointv3.ClusterLoadAssignment {
+	if zoneAware != nil && zoneAware.PreferLocal != nil {
+		return buildXdsZoneAwareClusterLoadAssignment(clusterName, destSettings)
+	}
+
 	localities := make([]*endpointv3.LocalityLbEndpoints, 0, len(destSettings))
 	for i, ds := range destSettings {
+		metadata := buildXdsEndpointMetadata(clusterName, i, ds)
 
-		endpoints := make([]*endpointv3.LbEndpoint, 0, len(ds.Endpoints))
-
-		var metadata *corev3.Metadata
-		if ds.TLS != nil {
-			metadata = &corev3.Metadata{
-				FilterMetadata: map[string]*structpb.Struct{
-					"envoy.transport_socket_match": {
-						Fields: map[string]*structpb.Value{
-							"name": structpb.NewStringValue(fmt.Sprintf("%s/tls/%d", clusterName, i)),
-						},
-					},
-				},
-			}
+		// Set locality weight
+		var weight uint32
+		if ds.Weight != nil {
+			weight = *ds.Weight
+		} else {
+			weight = 1
 		}
 
-		for _, irEp := range ds.Endpoints {
-			lbEndpoint := &endpointv3.LbEndpoint{
-				Metadata: metadata,
-				HostIdentifier: &endpointv3.LbEndpoint_Endpoint{
-					Endpoint: &endpointv3.Endpoint{
-						Address: buildAddress(irEp),
+		// Envoy requires a distinct region to be set for each LocalityLbEndpoints.
+		// If we don't do this, Envoy will merge all LocalityLbEndpoints into one.
+		// We use the name of the backendRef as a pseudo region name.
+		region := fmt.Sprintf("%s/backend/%d", clusterName, i)
+
+		if zoneAware != nil && len(zoneAware.WeightedZones) > 0 {
+			// Split the endpoints of the backendRef by zone, and weight each zone
+			// with the product of the backendRef weight and the zone weight.
+			for _, ze := range groupEndpointsByZone(ds.Endpoints) {
+				localities = append(localities, &endpointv3.LocalityLbEndpoints{
+					Locality: &corev3.Locality{
+						Region: region,
+						Zone:   ze.zone,
 					},
-				},
+					LbEndpoints:         buildXdsLbEndpoints(ze.endpoints, metadata, 1),
+					LoadBalancingWeight: &wrapperspb.UInt32Value{Value: weight * zoneWeight(zoneAware.WeightedZones, ze.zone)},
+					Priority:            ptr.Deref(ds.Priority, 0),
+				})
 			}
-			// Set default weight of 1 for all endpoints.
-			lbEndpoint.LoadBalancingWeight = &wrapperspb.UInt32Value{Value: 1}
-			endpoints = append(endpoints, lbEndpoint)
+			continue
 		}
 
-		// Envoy requires a distinct region to be set for each LocalityLbEndpoints.
-		// If we don't do this, Envoy will merge all LocalityLbEndpoints into one.
-		// We use the name of the backendRef as a pseudo region name.
 		locality := &endpointv3.LocalityLbEndpoints{
 			Locality: &corev3.Locality{
-				Region: fmt.Sprintf("%s/backend/%d", clusterName, i),
+				Region: region,
 			},
-			LbEndpoints: endpoints,
+			LbEndpoints: buildXdsLbEndpoints(ds.Endpoints, metadata, 1),
 			Priority:    0,
 		}
 
-		// Set locality weight
-		var weight uint32
-		if ds.Weight != nil {
-			weight = *ds.Weight
-		} else {
-			weight = 1
-		}
 		locality.LoadBalancingWeight = &wrapperspb.UInt32Value{Value: weight}
 		locality.Priority = ptr.Deref(ds.Priority, 0)
 		localities = append(localities, locality)
@@ -473,6 +494,106 @@ func buildXdsClusterLoadAssignment(clusterName string, destSettings []*ir.Destin
 	return &endpointv3.ClusterLoadAssignment{ClusterName: clusterName, Endpoints: localities}
 }
 
+// buildXdsZoneAwareClusterLoadAssignment builds a ClusterLoadAssignment for the Envoy
+// zone-aware routing, which requires the localities of the upstream endpoints to match
+// the localities of the local cluster. So the endpoints are grouped by zone and priority
+// without a pseudo region, and the backendRef weight is set on each endpoint instead.
+func buildXdsZoneAwareClusterLoadAssignment(clusterName string, destSettings []*ir.DestinationSetting) *endpointv3.ClusterLoadAssignment {
+	type localityKey struct {
+		priority uint32
+		zone     string
+	}
+	var localities []*endpointv3.LocalityLbEndpoints
+	localityIndex := make(map[localityKey]int)
+	for i, ds := range destSettings {
+		metadata := buildXdsEndpointMetadata(clusterName, i, ds)
+		weight := ptr.Deref(ds.Weight, 1)
+		priority := ptr.Deref(ds.Priority, 0)
+
+		for _, ze := range groupEndpointsByZone(ds.Endpoints) {
+			key := localityKey{priority: priority, zone: ze.zone}
+			idx, ok := localityIndex[key]
+			if !ok {
+				idx = len(localities)
+				localityIndex[key] = idx
+				localities = append(localities, &endpointv3.LocalityLbEndpoints{
+					Locality: &corev3.Locality{
+						Zone: ze.zone,
+					},
+					Priority: priority,
+				})
+			}
+			localities[idx].LbEndpoints = append(localities[idx].LbEndpoints,
+				buildXdsLbEndpoints(ze.endpoints, metadata, weight)...)
+		}
+	}
+	return &endpointv3.ClusterLoadAssignment{ClusterName: clusterName, Endpoints: localities}
+}
+
+func buildXdsEndpointMetadata(clusterName string, index int, ds *ir.DestinationSetting) *corev3.Metadata {
+	if ds.TLS == nil {
+		return nil
+	}
+	return &corev3.Metadata{
+		FilterMetadata: map[string]*structpb.Struct{
+			"envoy.transport_socket_match": {
+				Fields: map[string]*structpb.Value{
+					"name": structpb.NewStringValue(fmt.Sprintf("%s/tls/%d", clusterName, index)),
+				},
+			},
+		},
+	}
+}
+
+func buildXdsLbEndpoints(irEndpoints []*ir.DestinationEndpoint, metadata *corev3.Metadata, weight uint32) []*endpointv3.LbEndpoint {
+	endpoints := make([]*endpointv3.LbEndpoint, 0, len(irEndpoints))
+	for _, irEp := range irEndpoints {
+		endpoints = append(endpoints, &endpointv3.LbEndpoint{
+			Metadata: metadata,
+			HostIdentifier: &endpointv3.LbEndpoint_Endpoint{
+				Endpoint: &endpointv3.Endpoint{
+					Address: buildAddress(irEp),
+				},
+			},
+			LoadBalancingWeight: &wrapperspb.UInt32Value{Value: weight},
+		})
+	}
+	return endpoints
+}
+
+type zoneEndpoints struct {
+	zone      string
+	endpoints []*ir.DestinationEndpoint
+}
+
+// groupEndpointsByZone groups the endpoints by zone, in the order the zones first appear.
+// The endpoints without zone information are grouped under the empty zone.
+func groupEndpointsByZone(irEndpoints []*ir.DestinationEndpoint) []*zoneEndpoints {
+	var zones []*zoneEndpoints
+	zoneIndex := make(map[string]int)
+	for _, irEp := range irEndpoints {
+		zone := ptr.Deref(irEp.Zone, "")
+		idx, ok := zoneIndex[zone]
+		if !ok {
+			idx = len(zones)
+			zoneIndex[zone] = idx
+			zones = append(zones, &zoneEndpoints{zone: zone})
+		}
+		zones[idx].endpoints = append(zones[idx].endpoints, irEp)
+	}
+	return zones
+}
+
+// zoneWeight returns the weight of the provided zone, defaulting to 1 if it's not listed.
+func zoneWeight(weightedZones []ir.WeightedZone, zone string) uint32 {
+	for _, wz := range weightedZones {
+		if wz.Zone == zone {
+			return wz.Weight
+		}
+	}
+	return 1
+}
+
 func buildTypedExtensionProtocolOptions(args *xdsClusterArgs) map[string]*anypb.Any {
 	requiresHTTP2Options := false
 	for _, ds := range args.settings {
@@ -765,6 +886,9 @@ func (httpRoute *HTTPRouteTranslator) asClusterArgs(extra *ExtraArgs) *xdsCluste
 		clusterArgs.loadBalancer = bt.LoadBalancer
 		clusterArgs.proxyProtocol = bt.ProxyProtocol
 		clusterArgs.circuitBreaker = bt.CircuitBreaker
+		if bt.Retry != nil {
+			clusterArgs.retryBudget = bt.Retry.Budget
+		}
 		clusterArgs.healthCheck = bt.HealthCheck
 		clusterArgs.timeout = bt.Timeout
 		clusterArgs.tcpkeepalive = bt.TCPKeepalive
@@ -0,0 +1,270 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	brotliv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/brotli/compressor/v3"
+	gzipv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/gzip/compressor/v3"
+	zstdv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/compression/zstd/compressor/v3"
+	compressorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/compressor/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&compressor{})
+}
+
+type compressor struct{}
+
+var _ httpFilter = &compressor{}
+
+// patchHCM builds and appends the compressor Filters to the HTTP Connection
+// Manager if applicable.
+// Note: Envoy doesn't support per-route compressor library config, so this method
+// creates a compressor filter for each distinct compressor config, at each position
+// of the Compression lists of the routes.
+// The filters are disabled by default. They are enabled on the route level.
+// When multiple compressor filters are enabled for a route, Envoy picks the one
+// preferred by the client's Accept-Encoding header, and the first one in the filter
+// chain if the client has no preference among them. The filters are ordered by
+// their position in the Compression list, so the chain follows the preference
+// order of every route.
+func (*compressor) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		for i, c := range routeCompression(route) {
+			compressorProto, err := buildCompressor(c)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			name, err := compressorFilterName(compressorProto, c, i)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			if hcmContainsFilter(mgr, name) {
+				continue
+			}
+
+			filter, err := buildHCMCompressorFilter(name, compressorProto)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			mgr.HttpFilters = append(mgr.HttpFilters, filter)
+		}
+	}
+
+	return errs
+}
+
+// routeCompression returns the compression config of the provided route, if any.
+func routeCompression(irRoute *ir.HTTPRoute) []*ir.Compression {
+	if irRoute != nil && irRoute.Traffic != nil {
+		return irRoute.Traffic.Compression
+	}
+	return nil
+}
+
+// buildCompressor returns the compressor filter config of the provided IR Compression.
+func buildCompressor(c *ir.Compression) (*compressorv3.Compressor, error) {
+	library, err := buildCompressorLibrary(c)
+	if err != nil {
+		return nil, err
+	}
+
+	commonConfig := &compressorv3.Compressor_CommonDirectionConfig{
+		ContentType: c.ContentTypes,
+	}
+	if c.MinContentLength != nil {
+		commonConfig.MinContentLength = wrapperspb.UInt32(*c.MinContentLength)
+	}
+
+	return &compressorv3.Compressor{
+		CompressorLibrary: library,
+		ResponseDirectionConfig: &compressorv3.Compressor_ResponseDirectionConfig{
+			CommonConfig: commonConfig,
+		},
+	}, nil
+}
+
+// buildHCMCompressorFilter returns a compressor HTTP filter with the provided name
+// and compressor config.
+func buildHCMCompressorFilter(name string, compressorProto *compressorv3.Compressor) (*hcmv3.HttpFilter, error) {
+	compressorAny, err := protocov.ToAnyWithValidation(compressorProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     name,
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: compressorAny,
+		},
+	}, nil
+}
+
+// buildCompressorLibrary returns the compressor library extension of the provided
+// IR Compression.
+func buildCompressorLibrary(c *ir.Compression) (*corev3.TypedExtensionConfig, error) {
+	var (
+		name       string
+		libraryMsg proto.Message
+	)
+
+	switch c.Type {
+	case egv1a1.GzipCompressorType:
+		name = "envoy.compression.gzip.compressor"
+		libraryMsg = buildGzipCompressor(c.Gzip)
+	case egv1a1.BrotliCompressorType:
+		name = "envoy.compression.brotli.compressor"
+		libraryMsg = buildBrotliCompressor(c.Brotli)
+	case egv1a1.ZstdCompressorType:
+		name = "envoy.compression.zstd.compressor"
+		libraryMsg = buildZstdCompressor(c.Zstd)
+	default:
+		return nil, fmt.Errorf("unsupported compressor type: %s", c.Type)
+	}
+
+	libraryAny, err := protocov.ToAnyWithValidation(libraryMsg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &corev3.TypedExtensionConfig{
+		Name:        name,
+		TypedConfig: libraryAny,
+	}, nil
+}
+
+func buildGzipCompressor(gzip *egv1a1.GzipCompressor) *gzipv3.Gzip {
+	gzipProto := &gzipv3.Gzip{}
+	if gzip == nil {
+		return gzipProto
+	}
+
+	if gzip.CompressionLevel != nil {
+		// The Gzip compression level enum values match the zlib compression levels.
+		gzipProto.CompressionLevel = gzipv3.Gzip_CompressionLevel(*gzip.CompressionLevel)
+	}
+	if gzip.MemoryLevel != nil {
+		gzipProto.MemoryLevel = wrapperspb.UInt32(*gzip.MemoryLevel)
+	}
+	if gzip.WindowBits != nil {
+		gzipProto.WindowBits = wrapperspb.UInt32(*gzip.WindowBits)
+	}
+	return gzipProto
+}
+
+func buildBrotliCompressor(brotli *egv1a1.BrotliCompressor) *brotliv3.Brotli {
+	brotliProto := &brotliv3.Brotli{}
+	if brotli == nil {
+		return brotliProto
+	}
+
+	if brotli.Quality != nil {
+		brotliProto.Quality = wrapperspb.UInt32(*brotli.Quality)
+	}
+	if brotli.WindowBits != nil {
+		brotliProto.WindowBits = wrapperspb.UInt32(*brotli.WindowBits)
+	}
+	if brotli.EncoderMode != nil {
+		switch *brotli.EncoderMode {
+		case egv1a1.BrotliEncoderModeGeneric:
+			brotliProto.EncoderMode = brotliv3.Brotli_GENERIC
+		case egv1a1.BrotliEncoderModeText:
+			brotliProto.EncoderMode = brotliv3.Brotli_TEXT
+		case egv1a1.BrotliEncoderModeFont:
+			brotliProto.EncoderMode = brotliv3.Brotli_FONT
+		default:
+			brotliProto.EncoderMode = brotliv3.Brotli_DEFAULT
+		}
+	}
+	return brotliProto
+}
+
+func buildZstdCompressor(zstd *egv1a1.ZstdCompressor) *zstdv3.Zstd {
+	zstdProto := &zstdv3.Zstd{}
+	if zstd == nil {
+		return zstdProto
+	}
+
+	if zstd.CompressionLevel != nil {
+		zstdProto.CompressionLevel = wrapperspb.UInt32(*zstd.CompressionLevel)
+	}
+	if zstd.EnableChecksum != nil {
+		zstdProto.EnableChecksum = *zstd.EnableChecksum
+	}
+	return zstdProto
+}
+
+// compressorFilterName returns the name of the compressor filter for the provided
+// compressor config, at the provided position of a Compression list.
+// The name contains a digest of the config, so the routes with the same compressor
+// config at the same position share the filter. The position is the last segment
+// of the name, it's used to order the compressor filters.
+func compressorFilterName(compressorProto *compressorv3.Compressor, c *ir.Compression, index int) (string, error) {
+	config, err := proto.MarshalOptions{Deterministic: true}.Marshal(compressorProto)
+	if err != nil {
+		return "", err
+	}
+	return perRouteFilterName(egv1a1.EnvoyFilterCompressor,
+		fmt.Sprintf("%s/%s/%d", strings.ToLower(string(c.Type)), utils.Digest32(string(config)), index)), nil
+}
+
+func (*compressor) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the compression config if applicable.
+// Note: this method enables the corresponding compressor filters for the provided route.
+func (*compressor) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	for i, c := range routeCompression(irRoute) {
+		compressorProto, err := buildCompressor(c)
+		if err != nil {
+			return err
+		}
+		name, err := compressorFilterName(compressorProto, c, i)
+		if err != nil {
+			return err
+		}
+		if err := enableFilterOnRoute(route, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,304 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	credentialinjectorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/credential_injector/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	genericv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/generic/v3"
+	oauth2credv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/oauth2/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"google.golang.org/protobuf/types/known/durationpb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&credentialInjector{})
+}
+
+type credentialInjector struct{}
+
+var _ httpFilter = &credentialInjector{}
+
+// patchHCM builds and appends the credential injector Filters to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: this method creates a credential injector filter for each route that contains a
+// CredentialInjection or an OAuth2ClientCredentials config. The filter is disabled by default.
+// It is enabled on the route level.
+func (*credentialInjector) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		// Only generates one credential injector filter for each unique name.
+		// For example, if there are two routes using the same HTTPRouteFilter,
+		// only one credential injector filter will be generated.
+		if route.CredentialInjection != nil &&
+			!hcmContainsFilter(mgr, credentialInjectorFilterName(route.CredentialInjection)) {
+			filter, err := buildHCMCredentialInjectorFilter(route.CredentialInjection)
+			if err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				mgr.HttpFilters = append(mgr.HttpFilters, filter)
+			}
+		}
+
+		if cc := routeOAuth2ClientCredentials(route); cc != nil &&
+			!hcmContainsFilter(mgr, oauth2ClientCredentialsFilterName(cc)) {
+			filter, err := buildHCMOAuth2ClientCredentialsFilter(cc)
+			if err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				mgr.HttpFilters = append(mgr.HttpFilters, filter)
+			}
+		}
+	}
+
+	return errs
+}
+
+// routeOAuth2ClientCredentials returns the OAuth2 client credentials config of
+// the provided route, if any.
+func routeOAuth2ClientCredentials(irRoute *ir.HTTPRoute) *ir.OAuth2ClientCredentials {
+	if irRoute != nil && irRoute.Traffic != nil {
+		return irRoute.Traffic.OAuth2ClientCredentials
+	}
+	return nil
+}
+
+// buildHCMCredentialInjectorFilter returns a credential injector HTTP filter from
+// the provided IR CredentialInjection.
+// The credential is delivered to Envoy as a generic SDS secret.
+func buildHCMCredentialInjectorFilter(credentialInjection *ir.CredentialInjection) (*hcmv3.HttpFilter, error) {
+	genericCredential := &genericv3.Generic{
+		Credential: &tlsv3.SdsSecretConfig{
+			Name:      credentialInjectorSecretName(credentialInjection),
+			SdsConfig: makeConfigSource(),
+		},
+		Header: credentialInjection.Header,
+	}
+
+	genericCredentialAny, err := protocov.ToAnyWithValidation(genericCredential)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialInjectorProto := &credentialinjectorv3.CredentialInjector{
+		Overwrite: credentialInjection.Overwrite,
+		Credential: &corev3.TypedExtensionConfig{
+			Name:        "envoy.http.injected_credentials.generic",
+			TypedConfig: genericCredentialAny,
+		},
+	}
+
+	credentialInjectorAny, err := protocov.ToAnyWithValidation(credentialInjectorProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     credentialInjectorFilterName(credentialInjection),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: credentialInjectorAny,
+		},
+	}, nil
+}
+
+// buildHCMOAuth2ClientCredentialsFilter returns a credential injector HTTP filter
+// that obtains an OAuth2 access token with the client credentials grant from the
+// provided IR OAuth2ClientCredentials.
+// Envoy caches the access token until it expires, and always overwrites the
+// Authorization header of the request with it.
+func buildHCMOAuth2ClientCredentialsFilter(cc *ir.OAuth2ClientCredentials) (*hcmv3.HttpFilter, error) {
+	var (
+		tokenEndpointCluster string
+		err                  error
+	)
+
+	if cc.Destination != nil && len(cc.Destination.Settings) > 0 {
+		tokenEndpointCluster = cc.Destination.Name
+	} else {
+		var cluster *urlCluster
+		if cluster, err = url2Cluster(cc.TokenEndpoint); err != nil {
+			return nil, err
+		}
+		if cluster.endpointType == EndpointTypeStatic {
+			return nil, fmt.Errorf(
+				"static IP cluster is not allowed: %s",
+				cc.TokenEndpoint)
+		}
+		tokenEndpointCluster = cluster.name
+	}
+
+	authType := oauth2credv3.OAuth2_BASIC_AUTH
+	if cc.AuthenticationMethod == egv1a1.OAuth2ClientSecretPost {
+		authType = oauth2credv3.OAuth2_URL_ENCODED_BODY
+	}
+
+	oauth2Credential := &oauth2credv3.OAuth2{
+		TokenEndpoint: &corev3.HttpUri{
+			Uri: cc.TokenEndpoint,
+			HttpUpstreamType: &corev3.HttpUri_Cluster{
+				Cluster: tokenEndpointCluster,
+			},
+			Timeout: &durationpb.Duration{
+				Seconds: defaultExtServiceRequestTimeout,
+			},
+		},
+		Scopes: cc.Scopes,
+		FlowType: &oauth2credv3.OAuth2_ClientCredentials_{
+			ClientCredentials: &oauth2credv3.OAuth2_ClientCredentials{
+				ClientId: cc.ClientID,
+				ClientSecret: &tlsv3.SdsSecretConfig{
+					Name:      oauth2ClientCredentialsSecretName(cc),
+					SdsConfig: makeConfigSource(),
+				},
+				AuthType: authType,
+			},
+		},
+	}
+	if cc.TokenFetchRetryInterval != nil {
+		oauth2Credential.TokenFetchRetryInterval = durationpb.New(cc.TokenFetchRetryInterval.Duration)
+	}
+
+	oauth2CredentialAny, err := protocov.ToAnyWithValidation(oauth2Credential)
+	if err != nil {
+		return nil, err
+	}
+
+	credentialInjectorProto := &credentialinjectorv3.CredentialInjector{
+		Overwrite: true,
+		Credential: &corev3.TypedExtensionConfig{
+			Name:        "envoy.http.injected_credentials.oauth2",
+			TypedConfig: oauth2CredentialAny,
+		},
+	}
+
+	credentialInjectorAny, err := protocov.ToAnyWithValidation(credentialInjectorProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     oauth2ClientCredentialsFilterName(cc),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: credentialInjectorAny,
+		},
+	}, nil
+}
+
+func oauth2ClientCredentialsFilterName(cc *ir.OAuth2ClientCredentials) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterCredentialInjector, cc.Name)
+}
+
+func oauth2ClientCredentialsSecretName(cc *ir.OAuth2ClientCredentials) string {
+	return fmt.Sprintf("credential_injector/client_secret/%s", cc.Name)
+}
+
+func credentialInjectorFilterName(credentialInjection *ir.CredentialInjection) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterCredentialInjector, credentialInjection.Name)
+}
+
+func credentialInjectorSecretName(credentialInjection *ir.CredentialInjection) string {
+	return fmt.Sprintf("credential_injector/%s", credentialInjection.Name)
+}
+
+// patchResources creates the SDS secrets that hold the credentials to be injected,
+// and the clusters of the OAuth2 token endpoints.
+func (*credentialInjector) patchResources(tCtx *types.ResourceVersionTable, routes []*ir.HTTPRoute) error {
+	if tCtx == nil || tCtx.XdsResources == nil {
+		return errors.New("xds resource table is nil")
+	}
+
+	var errs error
+
+	for _, route := range routes {
+		if route.CredentialInjection != nil {
+			secret := buildGenericSecret(
+				credentialInjectorSecretName(route.CredentialInjection),
+				route.CredentialInjection.Credential)
+			if err := addXdsSecret(tCtx, secret); err != nil {
+				errs = errors.Join(errs, err)
+			}
+		}
+
+		if cc := routeOAuth2ClientCredentials(route); cc != nil {
+			secret := buildGenericSecret(oauth2ClientCredentialsSecretName(cc), cc.ClientSecret)
+			if err := addXdsSecret(tCtx, secret); err != nil {
+				errs = errors.Join(errs, err)
+			}
+
+			// If the token endpoint has a destination, use it.
+			if cc.Destination != nil && len(cc.Destination.Settings) > 0 {
+				if err := createExtServiceXDSCluster(cc.Destination, cc.Traffic, tCtx); err != nil {
+					errs = errors.Join(errs, err)
+				}
+			} else {
+				// Create a cluster with the token endpoint url.
+				if err := createOAuth2TokenEndpointCluster(tCtx, cc.TokenEndpoint); err != nil {
+					errs = errors.Join(errs, err)
+				}
+			}
+		}
+	}
+
+	return errs
+}
+
+func buildGenericSecret(name string, secret []byte) *tlsv3.Secret {
+	return &tlsv3.Secret{
+		Name: name,
+		Type: &tlsv3.Secret_GenericSecret{
+			GenericSecret: &tlsv3.GenericSecret{
+				Secret: &corev3.DataSource{
+					Specifier: &corev3.DataSource_InlineBytes{
+						InlineBytes: secret,
+					},
+				},
+			},
+		},
+	}
+}
+
+// patchRoute patches the provided route with the credential injector config if applicable.
+// Note: this method enables the corresponding credential injector filter for the provided route.
+func (*credentialInjector) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if irRoute.CredentialInjection != nil {
+		if err := enableFilterOnRoute(route, credentialInjectorFilterName(irRoute.CredentialInjection)); err != nil {
+			return err
+		}
+	}
+	if cc := routeOAuth2ClientCredentials(irRoute); cc != nil {
+		if err := enableFilterOnRoute(route, oauth2ClientCredentialsFilterName(cc)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,169 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+	"fmt"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	csrfv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/csrf/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
+	typev3 "github.com/envoyproxy/go-control-plane/envoy/type/v3"
+	"google.golang.org/protobuf/types/known/anypb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&csrf{})
+}
+
+type csrf struct{}
+
+var _ httpFilter = &csrf{}
+
+// patchHCM builds and appends the CSRF Filter to the HTTP Connection Manager if
+// applicable.
+func (*csrf) patchHCM(
+	mgr *hcmv3.HttpConnectionManager,
+	irListener *ir.HTTPListener,
+) error {
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	if !listenerContainsCSRF(irListener) {
+		return nil
+	}
+
+	// Return early if filter already exists.
+	if hcmContainsFilter(mgr, egv1a1.EnvoyFilterCSRF.String()) {
+		return nil
+	}
+
+	csrfFilter, err := buildHCMCSRFFilter()
+	if err != nil {
+		return err
+	}
+
+	mgr.HttpFilters = append(mgr.HttpFilters, csrfFilter)
+
+	return nil
+}
+
+// buildHCMCSRFFilter returns a CSRF filter from the provided IR listener.
+// The filter isn't enforced at the HCM level, it's enabled on the routes with a
+// CSRF policy.
+func buildHCMCSRFFilter() (*hcmv3.HttpFilter, error) {
+	csrfProto := &csrfv3.CsrfPolicy{
+		FilterEnabled: csrfFractionalPercent(0),
+	}
+
+	csrfAny, err := protocov.ToAnyWithValidation(csrfProto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name: egv1a1.EnvoyFilterCSRF.String(),
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: csrfAny,
+		},
+	}, nil
+}
+
+// listenerContainsCSRF returns true if the provided listener has CSRF
+// policies attached to its routes.
+func listenerContainsCSRF(irListener *ir.HTTPListener) bool {
+	if irListener == nil {
+		return false
+	}
+
+	for _, route := range irListener.Routes {
+		if route.Security != nil && route.Security.CSRF != nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// patchRoute patches the provided route with the CSRF config if applicable.
+func (*csrf) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if irRoute.Security == nil || irRoute.Security.CSRF == nil {
+		return nil
+	}
+
+	filterCfg := route.GetTypedPerFilterConfig()
+	if _, ok := filterCfg[egv1a1.EnvoyFilterCSRF.String()]; ok {
+		// This should not happen since this is the only place where the CSRF
+		// filter is added in a route.
+		return fmt.Errorf("route already contains csrf config: %+v", route)
+	}
+
+	var (
+		additionalOrigins []*matcherv3.StringMatcher
+		c                 = irRoute.Security.CSRF
+	)
+
+	for _, origin := range c.AdditionalOrigins {
+		additionalOrigins = append(additionalOrigins, buildXdsStringMatcher(origin))
+	}
+
+	routeCfgProto := &csrfv3.CsrfPolicy{
+		FilterEnabled:     csrfFractionalPercent(100),
+		AdditionalOrigins: additionalOrigins,
+	}
+
+	// In shadow mode, the filter evaluates the requests and emits statistics
+	// without rejecting them.
+	if c.Shadow {
+		routeCfgProto.FilterEnabled = csrfFractionalPercent(0)
+		routeCfgProto.ShadowEnabled = csrfFractionalPercent(100)
+	}
+
+	routeCfgAny, err := protocov.ToAnyWithValidation(routeCfgProto)
+	if err != nil {
+		return err
+	}
+
+	if filterCfg == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+
+	route.TypedPerFilterConfig[egv1a1.EnvoyFilterCSRF.String()] = routeCfgAny
+
+	return nil
+}
+
+func csrfFractionalPercent(percent uint32) *corev3.RuntimeFractionalPercent {
+	return &corev3.RuntimeFractionalPercent{
+		DefaultValue: &typev3.FractionalPercent{
+			Numerator:   percent,
+			Denominator: typev3.FractionalPercent_HUNDRED,
+		},
+	}
+}
+
+func (*csrf) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
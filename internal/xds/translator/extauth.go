@@ -6,12 +6,19 @@
 package translator
 
 import (
+	"bytes"
+	_ "embed"
 	"errors"
+	"fmt"
 	"net/url"
+	"strconv"
+	"strings"
+	"text/template"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	extauthv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ext_authz/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	matcherv3 "github.com/envoyproxy/go-control-plane/envoy/type/matcher/v3"
 	"google.golang.org/protobuf/types/known/anypb"
@@ -19,9 +26,51 @@ import (
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
 	"github.com/envoyproxy/gateway/internal/xds/types"
 )
 
+// Envoy doesn't have a native cache for the ext_authz filter, so the allow decisions
+// are cached by two Lua filters around the ext_authz filter:
+//   - a decision cache filter, which skips the ext_authz filter and replays the
+//     headers to backend for the cached requests, and caches the decisions
+//     recorded by the marker filter when the response is sent.
+//   - a decision cache marker filter, which records the requests allowed by the
+//     external authorization service in the dynamic metadata.
+//
+// The filters are named after the Lua filter type, with the decision cache
+// config name suffixes and the name of the ext_authz filter.
+const (
+	extAuthDecisionCacheConfigName       = "ext_authz_decision_cache"
+	extAuthDecisionCacheMarkerConfigName = "ext_authz_decision_cache_marker"
+)
+
+// extAuthDecisionCacheScriptTemplate is the Lua script of the decision cache filter.
+//
+//go:embed extauth_decision_cache.lua.tpl
+var extAuthDecisionCacheScriptTemplate string
+
+// extAuthDecisionCacheMarkerScriptTemplate is the Lua script of the decision cache
+// marker filter.
+//
+//go:embed extauth_decision_cache_marker.lua.tpl
+var extAuthDecisionCacheMarkerScriptTemplate string
+
+var (
+	extAuthDecisionCacheScript       = template.Must(template.New("ext_authz_decision_cache").Parse(extAuthDecisionCacheScriptTemplate))
+	extAuthDecisionCacheMarkerScript = template.Must(template.New("ext_authz_decision_cache_marker").Parse(extAuthDecisionCacheMarkerScriptTemplate))
+)
+
+// extAuthDecisionCacheScriptParameters holds the Lua literals rendered into the
+// decision cache scripts.
+type extAuthDecisionCacheScriptParameters struct {
+	MetadataNamespace string
+	Headers           []string
+	HeadersToBackend  []string
+	TTLMilliseconds   int64
+	MaxEntries        uint32
+}
+
 func init() {
 	registerHTTPFilter(&extAuth{})
 }
@@ -57,18 +106,97 @@ func (*extAuth) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPLi
 			continue
 		}
 
-		filter, err := buildHCMExtAuthFilter(route.Security.ExtAuth)
+		filters, err := buildHCMExtAuthFilters(route.Security.ExtAuth)
 		if err != nil {
 			errs = errors.Join(errs, err)
 			continue
 		}
 
-		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+		mgr.HttpFilters = append(mgr.HttpFilters, filters...)
 	}
 
 	return errs
 }
 
+// buildHCMExtAuthFilters returns the ext_authz HTTP filter from the provided IR ExtAuth,
+// and the decision cache filters if the decision cache is enabled.
+func buildHCMExtAuthFilters(extAuth *ir.ExtAuth) ([]*hcmv3.HttpFilter, error) {
+	extAuthFilter, err := buildHCMExtAuthFilter(extAuth)
+	if err != nil {
+		return nil, err
+	}
+	if extAuth.DecisionCache == nil {
+		return []*hcmv3.HttpFilter{extAuthFilter}, nil
+	}
+
+	cacheFilter, err := buildHCMExtAuthDecisionCacheFilter(
+		extAuthDecisionCacheFilterName(extAuth), extAuthDecisionCacheScript, extAuth)
+	if err != nil {
+		return nil, err
+	}
+	markerFilter, err := buildHCMExtAuthDecisionCacheFilter(
+		extAuthDecisionCacheMarkerFilterName(extAuth), extAuthDecisionCacheMarkerScript, extAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	return []*hcmv3.HttpFilter{cacheFilter, extAuthFilter, markerFilter}, nil
+}
+
+// buildHCMExtAuthDecisionCacheFilter returns a Lua HTTP filter that runs the provided
+// decision cache script.
+func buildHCMExtAuthDecisionCacheFilter(name string, script *template.Template, extAuth *ir.ExtAuth) (*hcmv3.HttpFilter, error) {
+	source, err := renderExtAuthDecisionCacheScript(script, extAuth)
+	if err != nil {
+		return nil, err
+	}
+
+	luaAny, err := protocov.ToAnyWithValidation(&luav3.Lua{
+		DefaultSourceCode: &corev3.DataSource{
+			Specifier: &corev3.DataSource_InlineString{
+				InlineString: source,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     name,
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: luaAny,
+		},
+	}, nil
+}
+
+// renderExtAuthDecisionCacheScript renders the provided decision cache script with
+// the settings of the provided IR ExtAuth.
+func renderExtAuthDecisionCacheScript(script *template.Template, extAuth *ir.ExtAuth) (string, error) {
+	params := extAuthDecisionCacheScriptParameters{
+		MetadataNamespace: strconv.Quote(extAuthDecisionCacheFilterName(extAuth)),
+		TTLMilliseconds:   extAuth.DecisionCache.TTL.Milliseconds(),
+		MaxEntries:        extAuth.DecisionCache.MaxEntries,
+	}
+	for _, header := range extAuth.DecisionCache.Headers {
+		params.Headers = append(params.Headers, strconv.Quote(strings.ToLower(header)))
+	}
+	// Only the headers to backend of the HTTP authorization services are known,
+	// a gRPC authorization service can add any header to the request.
+	if extAuth.HTTP != nil {
+		for _, header := range extAuth.HTTP.HeadersToBackend {
+			params.HeadersToBackend = append(params.HeadersToBackend, strconv.Quote(strings.ToLower(header)))
+		}
+	}
+
+	buf := &bytes.Buffer{}
+	if err := script.Execute(buf, params); err != nil {
+		return "", fmt.Errorf("failed to render ext auth decision cache script: %w", err)
+	}
+	return buf.String(), nil
+}
+
 // buildHCMExtAuthFilter returns an ext_authz HTTP filter from the provided IR HTTPRoute.
 func buildHCMExtAuthFilter(extAuth *ir.ExtAuth) (*hcmv3.HttpFilter, error) {
 	extAuthProto := extAuthConfig(extAuth)
@@ -94,6 +222,28 @@ func extAuthFilterName(extAuth *ir.ExtAuth) string {
 	return perRouteFilterName(egv1a1.EnvoyFilterExtAuthz, extAuth.Name)
 }
 
+// extAuthDecisionCacheFilterName returns the name of the decision cache filter,
+// which is also the namespace of the dynamic metadata of the decision cache filters.
+func extAuthDecisionCacheFilterName(extAuth *ir.ExtAuth) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterLua, fmt.Sprintf("%s/%s", extAuthDecisionCacheConfigName, extAuth.Name))
+}
+
+func extAuthDecisionCacheMarkerFilterName(extAuth *ir.ExtAuth) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterLua, fmt.Sprintf("%s/%s", extAuthDecisionCacheMarkerConfigName, extAuth.Name))
+}
+
+// isExtAuthDecisionCacheFilter returns true if the provided filter is a decision
+// cache filter.
+func isExtAuthDecisionCacheFilter(filter *hcmv3.HttpFilter) bool {
+	return strings.HasPrefix(filter.Name, perRouteFilterName(egv1a1.EnvoyFilterLua, extAuthDecisionCacheConfigName+"/"))
+}
+
+// isExtAuthDecisionCacheMarkerFilter returns true if the provided filter is a
+// decision cache marker filter.
+func isExtAuthDecisionCacheMarkerFilter(filter *hcmv3.HttpFilter) bool {
+	return strings.HasPrefix(filter.Name, perRouteFilterName(egv1a1.EnvoyFilterLua, extAuthDecisionCacheMarkerConfigName+"/"))
+}
+
 func extAuthConfig(extAuth *ir.ExtAuth) *extauthv3.ExtAuthz {
 	config := &extauthv3.ExtAuthz{
 		TransportApiVersion: corev3.ApiVersion_V3,
@@ -107,6 +257,30 @@ func extAuthConfig(extAuth *ir.ExtAuth) *extauthv3.ExtAuthz {
 		config.ClearRouteCache = *extAuth.RecomputeRoute
 	}
 
+	if extAuth.DecisionCache != nil {
+		// Skip the external authorization service if the decision cache filter
+		// has found an allowed decision for the request.
+		config.FilterEnabledMetadata = &matcherv3.MetadataMatcher{
+			Filter: extAuthDecisionCacheFilterName(extAuth),
+			Path: []*matcherv3.MetadataMatcher_PathSegment{
+				{
+					Segment: &matcherv3.MetadataMatcher_PathSegment_Key{
+						Key: "hit",
+					},
+				},
+			},
+			Value: &matcherv3.ValueMatcher{
+				MatchPattern: &matcherv3.ValueMatcher_PresentMatch{
+					PresentMatch: true,
+				},
+			},
+			Invert: true,
+		}
+		// The decision cache marker filter relies on this header to avoid caching
+		// the requests allowed because the external authorization service failed.
+		config.FailureModeAllowHeaderAdd = config.FailureModeAllow
+	}
+
 	var headersToExtAuth []*matcherv3.StringMatcher
 	for _, header := range extAuth.HeadersToExtAuth {
 		headersToExtAuth = append(headersToExtAuth, &matcherv3.StringMatcher{
@@ -131,7 +305,7 @@ func extAuthConfig(extAuth *ir.ExtAuth) *extauthv3.ExtAuthz {
 
 	if extAuth.HTTP != nil {
 		config.Services = &extauthv3.ExtAuthz_HttpService{
-			HttpService: httpService(extAuth.HTTP),
+			HttpService: httpService(extAuth.HTTP, extAuth.ContextExtensions),
 		}
 	} else if extAuth.GRPC != nil {
 		config.Services = &extauthv3.ExtAuthz_GrpcService{
@@ -149,7 +323,7 @@ func extAuthConfig(extAuth *ir.ExtAuth) *extauthv3.ExtAuthz {
 	return config
 }
 
-func httpService(http *ir.HTTPExtAuthService) *extauthv3.HttpService {
+func httpService(http *ir.HTTPExtAuthService, contextExtensions []*ir.ExtAuthContextExtension) *extauthv3.HttpService {
 	var (
 		uri              string
 		headersToBackend []*matcherv3.StringMatcher
@@ -160,6 +334,19 @@ func httpService(http *ir.HTTPExtAuthService) *extauthv3.HttpService {
 		PathPrefix: http.Path,
 	}
 
+	// Envoy only sends the context extensions to gRPC authorization services,
+	// so they're added as headers of the authorization request.
+	if len(contextExtensions) > 0 {
+		service.AuthorizationRequest = &extauthv3.AuthorizationRequest{}
+		for _, ce := range contextExtensions {
+			service.AuthorizationRequest.HeadersToAdd = append(service.AuthorizationRequest.HeadersToAdd,
+				&corev3.HeaderValue{
+					Key:   ce.Name,
+					Value: ce.Value,
+				})
+		}
+	}
+
 	u := url.URL{
 		// scheme should be decided by the TLS setting, but we don't have that info now.
 		// It's safe to set it to http because the ext auth filter doesn't use the
@@ -258,9 +445,67 @@ func (*extAuth) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
 	if irRoute.Security == nil || irRoute.Security.ExtAuth == nil {
 		return nil
 	}
-	filterName := extAuthFilterName(irRoute.Security.ExtAuth)
+	extAuth := irRoute.Security.ExtAuth
+	if extAuth.DecisionCache != nil {
+		if err := enableFilterOnRoute(route, extAuthDecisionCacheFilterName(extAuth)); err != nil {
+			return err
+		}
+		if err := enableFilterOnRoute(route, extAuthDecisionCacheMarkerFilterName(extAuth)); err != nil {
+			return err
+		}
+	}
+
+	filterName := extAuthFilterName(extAuth)
+	if extAuth.GRPC != nil && len(extAuth.ContextExtensions) > 0 {
+		return enableExtAuthWithContextExtensions(route, filterName, extAuth.ContextExtensions)
+	}
 	if err := enableFilterOnRoute(route, filterName); err != nil {
 		return err
 	}
 	return nil
 }
+
+// enableExtAuthWithContextExtensions enables the ext_authz filter for the provided
+// route, and attaches the context extensions to the check requests sent for it.
+func enableExtAuthWithContextExtensions(route *routev3.Route, filterName string,
+	contextExtensions []*ir.ExtAuthContextExtension,
+) error {
+	filterCfg := route.GetTypedPerFilterConfig()
+	if _, ok := filterCfg[filterName]; ok {
+		// This should not happen since this is the only place where the filter
+		// config is added in a route.
+		return fmt.Errorf("route already contains filter config: %s, %+v",
+			filterName, route)
+	}
+
+	checkSettings := &extauthv3.CheckSettings{
+		ContextExtensions: make(map[string]string, len(contextExtensions)),
+	}
+	for _, ce := range contextExtensions {
+		checkSettings.ContextExtensions[ce.Name] = ce.Value
+	}
+
+	perRouteAny, err := protocov.ToAnyWithValidation(&extauthv3.ExtAuthzPerRoute{
+		Override: &extauthv3.ExtAuthzPerRoute_CheckSettings{
+			CheckSettings: checkSettings,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	// Enable the filter for this route with the per-route config.
+	routeCfgAny, err := anypb.New(&routev3.FilterConfig{
+		Config: perRouteAny,
+	})
+	if err != nil {
+		return err
+	}
+
+	if filterCfg == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[filterName] = routeCfgAny
+
+	return nil
+}
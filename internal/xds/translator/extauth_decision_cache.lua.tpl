@@ -0,0 +1,93 @@
+-- Generated by Envoy Gateway. Caches the allow decisions of the external authorization service.
+local metadata_namespace = {{ .MetadataNamespace }}
+local key_headers = { {{- range $i, $h := .Headers }}{{ if $i }}, {{ end }}{{ $h }}{{ end -}} }
+local backend_headers = { {{- range $i, $h := .HeadersToBackend }}{{ if $i }}, {{ end }}{{ $h }}{{ end -}} }
+local ttl_ms = {{ .TTLMilliseconds }}
+local max_entries = {{ .MaxEntries }}
+
+-- The cache is kept in the Lua state of the worker thread, so it's shared by
+-- all the requests handled by the same worker.
+-- All the decisions have the same TTL, so the queue of the cached decisions is
+-- ordered by insertion and by expiration time at the same time.
+local cache = {}
+local queue = {}
+local head = 1
+local tail = 0
+local size = 0
+
+-- cache_key returns the key of the decision of a request. A decision is only
+-- reused for the same route, method, host and path, including the query.
+local function cache_key(request_handle, headers)
+  local values = {
+    request_handle:streamInfo():routeName(),
+    headers:get(":method") or "",
+    headers:get(":authority") or "",
+    headers:get(":path") or "",
+  }
+  for _, name in ipairs(key_headers) do
+    values[#values + 1] = headers:get(name) or ""
+  end
+  return table.concat(values, "\0")
+end
+
+-- pop removes the oldest decision from the cache.
+local function pop()
+  local decision = queue[head]
+  queue[head] = nil
+  head = head + 1
+  cache[decision.key] = nil
+  size = size - 1
+end
+
+-- store caches an allow decision, after removing the expired decisions. The
+-- oldest decision is evicted if the cache is still full.
+local function store(key, headers, now)
+  while head <= tail and queue[head].expires_at <= now do
+    pop()
+  end
+  -- Another request with the same key has been allowed in the meantime.
+  if cache[key] ~= nil then
+    return
+  end
+  if size >= max_entries then
+    pop()
+  end
+
+  local decision = { key = key, headers = headers, expires_at = now + ttl_ms }
+  tail = tail + 1
+  queue[tail] = decision
+  cache[key] = decision
+  size = size + 1
+end
+
+function envoy_on_request(request_handle)
+  local headers = request_handle:headers()
+  local key = cache_key(request_handle, headers)
+  local decision = cache[key]
+  if decision ~= nil and decision.expires_at > request_handle:timestamp() then
+    -- Replay the headers added by the external authorization service, the values
+    -- sent by the client are never forwarded for the cached requests.
+    for _, name in ipairs(backend_headers) do
+      local value = decision.headers[name]
+      if value ~= nil then
+        headers:replace(name, value)
+      else
+        headers:remove(name)
+      end
+    end
+    -- Skip the external authorization service.
+    request_handle:streamInfo():dynamicMetadata():set(metadata_namespace, "hit", true)
+    return
+  end
+  request_handle:streamInfo():dynamicMetadata():set(metadata_namespace, "key", key)
+end
+
+function envoy_on_response(response_handle)
+  local metadata = response_handle:streamInfo():dynamicMetadata():get(metadata_namespace)
+  -- Only the requests recorded by the marker filter have been explicitly allowed
+  -- by the external authorization service. The denials are never cached.
+  if metadata == nil or metadata["key"] == nil or metadata["allowed"] == nil then
+    return
+  end
+  store(metadata["key"], metadata["headers"] or {}, response_handle:timestamp())
+end
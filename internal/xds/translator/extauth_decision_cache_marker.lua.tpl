@@ -0,0 +1,34 @@
+-- Generated by Envoy Gateway. Records the requests allowed by the external authorization service.
+local metadata_namespace = {{ .MetadataNamespace }}
+local backend_headers = { {{- range $i, $h := .HeadersToBackend }}{{ if $i }}, {{ end }}{{ $h }}{{ end -}} }
+local failure_mode_allowed_header = "x-envoy-auth-failure-mode-allowed"
+
+function envoy_on_request(request_handle)
+  local headers = request_handle:headers()
+  -- The requests allowed because the external authorization service failed are not cached.
+  if headers:get(failure_mode_allowed_header) ~= nil then
+    headers:remove(failure_mode_allowed_header)
+    return
+  end
+
+  local dynamic_metadata = request_handle:streamInfo():dynamicMetadata()
+  local metadata = dynamic_metadata:get(metadata_namespace)
+  -- The cached requests have not been checked by the external authorization service.
+  if metadata == nil or metadata["key"] == nil then
+    return
+  end
+  dynamic_metadata:set(metadata_namespace, "allowed", true)
+
+  -- Record the headers added by the external authorization service, so that
+  -- they're replayed for the cached requests.
+  local values = {}
+  for _, name in ipairs(backend_headers) do
+    local value = headers:get(name)
+    if value ~= nil then
+      values[name] = value
+    end
+  end
+  if next(values) ~= nil then
+    dynamic_metadata:set(metadata_namespace, "headers", values)
+  end
+end
@@ -0,0 +1,376 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"cmp"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gopherlua "github.com/yuin/gopher-lua"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/envoyproxy/gateway/internal/ir"
+)
+
+// extAuthDecisionCacheTestFilterChain is a minimal Lua implementation of the Envoy
+// filter chain around the decision cache filters. A request runs through the
+// decision cache filter, the ext_authz filter and the decision cache marker filter,
+// then the response runs through the decision cache filter. The ext_authz filter
+// is skipped for the cached requests, and the global "checks" counts its checks.
+const extAuthDecisionCacheTestFilterChain = `
+checks = 0
+
+function send(now, route, headers, authz, authz_headers)
+  local metadata = {}
+  local header_map = {
+    get = function(_, k) return headers[k] end,
+    replace = function(_, k, v) headers[k] = v end,
+    remove = function(_, k) headers[k] = nil end,
+  }
+  local dynamic_metadata = {
+    get = function(_, ns) return metadata[ns] end,
+    set = function(_, ns, k, v)
+      if metadata[ns] == nil then metadata[ns] = {} end
+      metadata[ns][k] = v
+    end,
+  }
+  local handle = {
+    headers = function(_) return header_map end,
+    timestamp = function(_) return now end,
+    streamInfo = function(_)
+      return {
+        routeName = function(_) return route end,
+        dynamicMetadata = function(_) return dynamic_metadata end,
+      }
+    end,
+  }
+
+  cache_on_request(handle)
+  if metadata[metadata_namespace] == nil or metadata[metadata_namespace]["hit"] == nil then
+    checks = checks + 1
+    if authz == "deny" then
+      -- The local reply of the ext_authz filter skips the marker filter.
+      cache_on_response(handle)
+      return
+    end
+    for k, v in pairs(authz_headers) do
+      headers[k] = v
+    end
+    if authz == "error" then
+      headers["x-envoy-auth-failure-mode-allowed"] = "true"
+    end
+  end
+  marker_on_request(handle)
+  cache_on_response(handle)
+end
+`
+
+// extAuthDecisionCacheTestRequest is a request sent through the test filter chain.
+// The route, method and path default to extAuthDecisionCacheTestRoute, GET and /.
+type extAuthDecisionCacheTestRequest struct {
+	now           int64
+	route         string
+	method        string
+	path          string
+	headers       map[string]string
+	authz         string
+	authzHeaders  map[string]string
+	expectCheck   bool
+	expectHeaders map[string]string
+}
+
+const extAuthDecisionCacheTestRoute = "httproute/default/httproute-1/rule/0/match/0/www_example_com"
+
+func TestExtAuthDecisionCacheScripts(t *testing.T) {
+	extAuth := &ir.ExtAuth{
+		Name: "securitypolicy/default/policy-for-http-route",
+		HTTP: &ir.HTTPExtAuthService{
+			HeadersToBackend: []string{"X-User"},
+		},
+		DecisionCache: &ir.ExtAuthDecisionCache{
+			Headers:    []string{"Authorization"},
+			TTL:        metav1.Duration{Duration: 1500 * time.Millisecond},
+			MaxEntries: 2,
+		},
+	}
+
+	testCases := []struct {
+		name     string
+		requests []extAuthDecisionCacheTestRequest
+	}{
+		{
+			name: "allow is cached and replays the headers to backend",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					authzHeaders:  map[string]string{"x-user": "alice"},
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a", "x-user": "alice"},
+				},
+				{
+					now:           1000,
+					headers:       map[string]string{"authorization": "a", "x-user": "mallory"},
+					expectHeaders: map[string]string{"authorization": "a", "x-user": "alice"},
+				},
+			},
+		},
+		{
+			name: "missing headers to backend are removed on a hit",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					headers:       map[string]string{"authorization": "a", "x-user": "mallory"},
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+			},
+		},
+		{
+			name: "different keys are checked",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					headers:       map[string]string{"authorization": "b"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "b"},
+				},
+			},
+		},
+		{
+			name: "different paths are checked",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					path:          "/public",
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					path:          "/admin",
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "deny",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					path:          "/public?page=2",
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "deny",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					path:          "/public",
+					headers:       map[string]string{"authorization": "a"},
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+			},
+		},
+		{
+			name: "different methods are checked",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					method:        "DELETE",
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "deny",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+			},
+		},
+		{
+			name: "different routes are checked",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					route:         "httproute/default/httproute-2/rule/0/match/0/www_example_com",
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "deny",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+			},
+		},
+		{
+			name: "deny is not cached",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "deny",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+			},
+		},
+		{
+			name: "allow on authorization service failure is not cached",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "error",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+			},
+		},
+		{
+			name: "allow expires after the ttl",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					now:           1499,
+					headers:       map[string]string{"authorization": "a"},
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					now:           1500,
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+			},
+		},
+		{
+			name: "oldest allow is evicted when the cache is full",
+			requests: []extAuthDecisionCacheTestRequest{
+				{
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					now:           1,
+					headers:       map[string]string{"authorization": "b"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "b"},
+				},
+				{
+					now:           2,
+					headers:       map[string]string{"authorization": "c"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "c"},
+				},
+				{
+					now:           3,
+					headers:       map[string]string{"authorization": "b"},
+					expectHeaders: map[string]string{"authorization": "b"},
+				},
+				{
+					now:           4,
+					headers:       map[string]string{"authorization": "a"},
+					authz:         "allow",
+					expectCheck:   true,
+					expectHeaders: map[string]string{"authorization": "a"},
+				},
+				{
+					now:           5,
+					headers:       map[string]string{"authorization": "c"},
+					expectHeaders: map[string]string{"authorization": "c"},
+				},
+			},
+		},
+	}
+
+	cacheScript, err := renderExtAuthDecisionCacheScript(extAuthDecisionCacheScript, extAuth)
+	require.NoError(t, err)
+	markerScript, err := renderExtAuthDecisionCacheScript(extAuthDecisionCacheMarkerScript, extAuth)
+	require.NoError(t, err)
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := gopherlua.NewState()
+			defer l.Close()
+
+			require.NoError(t, l.DoString(cacheScript))
+			l.SetGlobal("cache_on_request", l.GetGlobal("envoy_on_request"))
+			l.SetGlobal("cache_on_response", l.GetGlobal("envoy_on_response"))
+			require.NoError(t, l.DoString(markerScript))
+			l.SetGlobal("marker_on_request", l.GetGlobal("envoy_on_request"))
+			l.SetGlobal("metadata_namespace", gopherlua.LString(extAuthDecisionCacheFilterName(extAuth)))
+			require.NoError(t, l.DoString(extAuthDecisionCacheTestFilterChain))
+
+			for i, request := range tc.requests {
+				t.Run(strconv.Itoa(i), func(t *testing.T) {
+					route := cmp.Or(request.route, extAuthDecisionCacheTestRoute)
+					headers := luaStringTable(l, request.headers)
+					headers.RawSetString(":method", gopherlua.LString(cmp.Or(request.method, "GET")))
+					headers.RawSetString(":authority", gopherlua.LString("www.example.com"))
+					headers.RawSetString(":path", gopherlua.LString(cmp.Or(request.path, "/")))
+
+					checks := l.GetGlobal("checks")
+					require.NoError(t, l.CallByParam(gopherlua.P{Fn: l.GetGlobal("send"), NRet: 0, Protect: true},
+						gopherlua.LNumber(request.now), gopherlua.LString(route), headers,
+						gopherlua.LString(request.authz), luaStringTable(l, request.authzHeaders)))
+
+					require.Equal(t, request.expectCheck, l.GetGlobal("checks") != checks)
+					remaining := map[string]string{}
+					headers.ForEach(func(k, v gopherlua.LValue) {
+						if !strings.HasPrefix(k.String(), ":") {
+							remaining[k.String()] = v.String()
+						}
+					})
+					require.Equal(t, request.expectHeaders, remaining)
+				})
+			}
+		})
+	}
+}
+
+func luaStringTable(l *gopherlua.LState, m map[string]string) *gopherlua.LTable {
+	table := l.NewTable()
+	for k, v := range m {
+		table.RawSetString(k, gopherlua.LString(v))
+	}
+	return table
+}
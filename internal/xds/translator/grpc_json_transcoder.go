@@ -0,0 +1,140 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	transcoderv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/grpc_json_transcoder/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"k8s.io/utils/ptr"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+func init() {
+	registerHTTPFilter(&grpcJSONTranscoder{})
+}
+
+type grpcJSONTranscoder struct{}
+
+var _ httpFilter = &grpcJSONTranscoder{}
+
+// patchHCM builds and appends the gRPC-JSON transcoder Filters to the HTTP
+// Connection Manager if applicable, and it does not already exist.
+// Note: this method creates a gRPC-JSON transcoder filter for each route that
+// contains a gRPC-JSON transcoder config, the routes sharing the same config
+// share the same filter.
+// The filter is disabled by default. It is enabled on the route level.
+func (*grpcJSONTranscoder) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		if !routeContainsGRPCJSONTranscoder(route) {
+			continue
+		}
+		transcoder := route.EnvoyExtensions.GRPCJSONTranscoder
+		if hcmContainsFilter(mgr, grpcJSONTranscoderFilterName(transcoder)) {
+			continue
+		}
+		filter, err := buildHCMGRPCJSONTranscoderFilter(transcoder)
+		if err != nil {
+			errs = errors.Join(errs, err)
+			continue
+		}
+		mgr.HttpFilters = append(mgr.HttpFilters, filter)
+	}
+
+	return errs
+}
+
+// buildHCMGRPCJSONTranscoderFilter returns a gRPC-JSON transcoder HTTP filter
+// from the provided IR GRPCJSONTranscoder.
+func buildHCMGRPCJSONTranscoderFilter(transcoder *ir.GRPCJSONTranscoder) (*hcmv3.HttpFilter, error) {
+	transcoderProto := &transcoderv3.GrpcJsonTranscoder{
+		DescriptorSet: &transcoderv3.GrpcJsonTranscoder_ProtoDescriptorBin{
+			ProtoDescriptorBin: transcoder.ProtoDescriptorBin,
+		},
+		Services: transcoder.Services,
+		// Keep the route matched by the JSON request, since the transcoded gRPC
+		// request may not match the route the filter is enabled for.
+		MatchIncomingRequestRoute: true,
+	}
+
+	if transcoder.RejectUnknownMethod {
+		transcoderProto.RequestValidationOptions = &transcoderv3.GrpcJsonTranscoder_RequestValidationOptions{
+			RejectUnknownMethod: true,
+		}
+	}
+
+	if po := transcoder.PrintOptions; po != nil {
+		transcoderProto.PrintOptions = &transcoderv3.GrpcJsonTranscoder_PrintOptions{
+			AddWhitespace:              ptr.Deref(po.AddWhitespace, false),
+			AlwaysPrintPrimitiveFields: ptr.Deref(po.AlwaysPrintPrimitiveFields, false),
+			AlwaysPrintEnumsAsInts:     ptr.Deref(po.AlwaysPrintEnumsAsInts, false),
+			PreserveProtoFieldNames:    ptr.Deref(po.PreserveProtoFieldNames, false),
+		}
+	}
+
+	transcoderAny, err := protocov.ToAnyWithValidation(transcoderProto)
+	if err != nil {
+		return nil, err
+	}
+
+	// All gRPC-JSON transcoder filters for all Routes are aggregated on HCM and
+	// disabled by default. Per-route config is used to enable the relevant filters
+	// on appropriate routes.
+	return &hcmv3.HttpFilter{
+		Name:     grpcJSONTranscoderFilterName(transcoder),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: transcoderAny,
+		},
+	}, nil
+}
+
+func grpcJSONTranscoderFilterName(transcoder *ir.GRPCJSONTranscoder) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterGRPCJSONTranscoder, transcoder.Name)
+}
+
+// routeContainsGRPCJSONTranscoder returns true if a GRPCJSONTranscoder exists for
+// the provided route.
+func routeContainsGRPCJSONTranscoder(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.EnvoyExtensions != nil && irRoute.EnvoyExtensions.GRPCJSONTranscoder != nil
+}
+
+func (*grpcJSONTranscoder) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the gRPC-JSON transcoder config if
+// applicable.
+// Note: this method enables the corresponding gRPC-JSON transcoder filter for the
+// provided route.
+func (*grpcJSONTranscoder) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsGRPCJSONTranscoder(irRoute) {
+		return nil
+	}
+
+	return enableFilterOnRoute(route, grpcJSONTranscoderFilterName(irRoute.EnvoyExtensions.GRPCJSONTranscoder))
+}
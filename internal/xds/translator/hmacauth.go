@@ -0,0 +1,292 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	_ "embed"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	bufferv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/buffer/v3"
+	credentialinjectorv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/credential_injector/v3"
+	luav3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	genericv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/http/injected_credentials/generic/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	"google.golang.org/protobuf/types/known/structpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+// Envoy doesn't have a native filter for HMAC signature verification, so the
+// signature is verified by a chain of three filters:
+//   - a buffer filter, which bounds the size of the request body.
+//   - a credential injector filter, which injects the shared secret from an SDS
+//     secret into the hmacAuthSecretHeader request header.
+//   - a Lua filter, which removes the secret header and verifies the signature.
+//
+// The filters are named after their types, with the hmacAuthConfigName suffix.
+const (
+	hmacAuthConfigName   = "hmac_auth"
+	hmacAuthSecretHeader = "x-envoy-gateway-hmac-auth-secret"
+)
+
+// hmacAuthScript is the Lua script used to verify the HMAC signature of the requests.
+// The same script is shared by all the routes, the settings of a route are passed
+// to the script in the filter context.
+//
+//go:embed hmacauth.lua
+var hmacAuthScript string
+
+func init() {
+	registerHTTPFilter(&hmacAuth{})
+}
+
+type hmacAuth struct{}
+
+var _ httpFilter = &hmacAuth{}
+
+// patchHCM builds and appends the HMAC auth Filters to the HTTP Connection Manager
+// if applicable, and they do not already exist.
+// Note: the buffer and Lua filters are shared by all the routes, and a credential
+// injector filter is created for each HMACAuth config. The filters are disabled
+// by default. They are enabled on the route level.
+func (*hmacAuth) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		if !routeContainsHMACAuth(route) {
+			continue
+		}
+
+		if !hcmContainsFilter(mgr, hmacAuthFilterName(egv1a1.EnvoyFilterBuffer)) {
+			filter, err := buildHCMHMACAuthBufferFilter()
+			if err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				mgr.HttpFilters = append(mgr.HttpFilters, filter)
+			}
+		}
+
+		if !hcmContainsFilter(mgr, hmacAuthSecretInjectorFilterName(route.Security.HMACAuth)) {
+			filter, err := buildHCMHMACAuthSecretInjectorFilter(route.Security.HMACAuth)
+			if err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				mgr.HttpFilters = append(mgr.HttpFilters, filter)
+			}
+		}
+
+		if !hcmContainsFilter(mgr, hmacAuthFilterName(egv1a1.EnvoyFilterLua)) {
+			filter, err := buildHCMHMACAuthLuaFilter()
+			if err != nil {
+				errs = errors.Join(errs, err)
+			} else {
+				mgr.HttpFilters = append(mgr.HttpFilters, filter)
+			}
+		}
+	}
+
+	return errs
+}
+
+// routeContainsHMACAuth returns true if HMACAuth exists for the provided route.
+func routeContainsHMACAuth(irRoute *ir.HTTPRoute) bool {
+	return irRoute != nil && irRoute.Security != nil && irRoute.Security.HMACAuth != nil
+}
+
+// buildHCMHMACAuthBufferFilter returns the buffer HTTP filter that buffers the
+// request body before the signature is verified.
+func buildHCMHMACAuthBufferFilter() (*hcmv3.HttpFilter, error) {
+	// The limit at the HTTP connection manager level is never used, since the
+	// filter is only enabled by the routes, which override it.
+	bufferAny, err := protocov.ToAnyWithValidation(&bufferv3.Buffer{
+		MaxRequestBytes: wrapperspb.UInt32(math.MaxUint32),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     hmacAuthFilterName(egv1a1.EnvoyFilterBuffer),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: bufferAny,
+		},
+	}, nil
+}
+
+// buildHCMHMACAuthSecretInjectorFilter returns a credential injector HTTP filter
+// that injects the shared secret of the provided IR HMACAuth into the secret header.
+// The header is always overwritten, so the clients can't provide their own secret.
+func buildHCMHMACAuthSecretInjectorFilter(hmacAuth *ir.HMACAuth) (*hcmv3.HttpFilter, error) {
+	genericCredentialAny, err := protocov.ToAnyWithValidation(&genericv3.Generic{
+		Credential: &tlsv3.SdsSecretConfig{
+			Name:      hmacAuthSecretName(hmacAuth),
+			SdsConfig: makeConfigSource(),
+		},
+		Header: hmacAuthSecretHeader,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	credentialInjectorAny, err := protocov.ToAnyWithValidation(&credentialinjectorv3.CredentialInjector{
+		Overwrite: true,
+		Credential: &corev3.TypedExtensionConfig{
+			Name:        "envoy.http.injected_credentials.generic",
+			TypedConfig: genericCredentialAny,
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     hmacAuthSecretInjectorFilterName(hmacAuth),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: credentialInjectorAny,
+		},
+	}, nil
+}
+
+// buildHCMHMACAuthLuaFilter returns the Lua HTTP filter that verifies the HMAC
+// signature. The request is rejected if the script fails.
+func buildHCMHMACAuthLuaFilter() (*hcmv3.HttpFilter, error) {
+	luaAny, err := protocov.ToAnyWithValidation(&luav3.Lua{
+		DefaultSourceCode: &corev3.DataSource{
+			Specifier: &corev3.DataSource_InlineString{
+				InlineString: hmacAuthScript + luaFailClosedWrapper,
+			},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &hcmv3.HttpFilter{
+		Name:     hmacAuthFilterName(egv1a1.EnvoyFilterLua),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: luaAny,
+		},
+	}, nil
+}
+
+// buildHMACAuthFilterContext returns the filter context that passes the settings
+// of the provided IR HMACAuth to the Lua script.
+func buildHMACAuthFilterContext(hmacAuth *ir.HMACAuth) (*structpb.Struct, error) {
+	fields := map[string]any{
+		"secretHeader":    hmacAuthSecretHeader,
+		"signatureHeader": hmacAuth.SignatureHeader,
+		"signaturePrefix": hmacAuth.SignaturePrefix,
+		"algorithm":       string(hmacAuth.Algorithm),
+		"encoding":        string(hmacAuth.Encoding),
+	}
+	if hmacAuth.Timestamp != nil {
+		fields["timestampHeader"] = hmacAuth.Timestamp.Header
+		fields["maxSkewSeconds"] = int64(hmacAuth.Timestamp.MaxSkew.Seconds())
+		fields["timestampSeparator"] = hmacAuth.Timestamp.Separator
+		fields["payloadPrefix"] = hmacAuth.Timestamp.PayloadPrefix
+	}
+	return structpb.NewStruct(fields)
+}
+
+// hmacAuthFilterName returns the name of the HMAC auth filter of the provided type
+// that is shared by all the routes.
+func hmacAuthFilterName(filterType egv1a1.EnvoyFilter) string {
+	return perRouteFilterName(filterType, hmacAuthConfigName)
+}
+
+func hmacAuthSecretInjectorFilterName(hmacAuth *ir.HMACAuth) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterCredentialInjector, fmt.Sprintf("%s/%s", hmacAuthConfigName, hmacAuth.Name))
+}
+
+func hmacAuthSecretName(hmacAuth *ir.HMACAuth) string {
+	return fmt.Sprintf("%s/%s", hmacAuthConfigName, hmacAuth.Name)
+}
+
+// isHMACAuthFilter returns true if the provided filter is the HMAC auth filter
+// of the provided type.
+func isHMACAuthFilter(filter *hcmv3.HttpFilter, filterType egv1a1.EnvoyFilter) bool {
+	return strings.HasPrefix(filter.Name, hmacAuthFilterName(filterType))
+}
+
+// patchResources creates the SDS secrets that hold the hex encoded shared secrets.
+func (*hmacAuth) patchResources(tCtx *types.ResourceVersionTable, routes []*ir.HTTPRoute) error {
+	if tCtx == nil || tCtx.XdsResources == nil {
+		return errors.New("xds resource table is nil")
+	}
+
+	var errs error
+	for _, route := range routes {
+		if !routeContainsHMACAuth(route) {
+			continue
+		}
+		hmacAuth := route.Security.HMACAuth
+		secret := buildGenericSecret(hmacAuthSecretName(hmacAuth), []byte(hex.EncodeToString(hmacAuth.Secret)))
+		if err := addXdsSecret(tCtx, secret); err != nil {
+			errs = errors.Join(errs, err)
+		}
+	}
+	return errs
+}
+
+// patchRoute patches the provided route with the HMAC auth config if applicable.
+// Note: this method enables the HMAC auth filters for the provided route, with
+// the request body size limit and the settings of the route.
+func (*hmacAuth) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if !routeContainsHMACAuth(irRoute) {
+		return nil
+	}
+
+	hmacAuth := irRoute.Security.HMACAuth
+
+	if err := enableFilterOnRouteWithConfig(route, hmacAuthFilterName(egv1a1.EnvoyFilterBuffer), &bufferv3.BufferPerRoute{
+		Override: &bufferv3.BufferPerRoute_Buffer{
+			Buffer: &bufferv3.Buffer{
+				MaxRequestBytes: wrapperspb.UInt32(hmacAuth.MaxRequestBytes),
+			},
+		},
+	}); err != nil {
+		return err
+	}
+
+	if err := enableFilterOnRoute(route, hmacAuthSecretInjectorFilterName(hmacAuth)); err != nil {
+		return err
+	}
+
+	filterContext, err := buildHMACAuthFilterContext(hmacAuth)
+	if err != nil {
+		return err
+	}
+	return enableFilterOnRouteWithConfig(route, hmacAuthFilterName(egv1a1.EnvoyFilterLua), &luav3.LuaPerRoute{
+		FilterContext: filterContext,
+	})
+}
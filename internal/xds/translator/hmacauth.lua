@@ -0,0 +1,192 @@
+-- Generated by Envoy Gateway. Verifies the HMAC signature of the request body.
+--
+-- The settings of the route are read from the filter context. The shared secret
+-- is injected, hex encoded, in a request header by a credential injector filter
+-- from an SDS secret; the header is removed before anything else is done.
+-- The request body is bounded by a buffer filter in front of this filter.
+local bit = require("bit")
+local band, bor, bxor, bnot = bit.band, bit.bor, bit.bxor, bit.bnot
+local lshift, rshift, rol, ror, tobit = bit.lshift, bit.rshift, bit.rol, bit.ror, bit.tobit
+
+local sha256_k = {
+  0x428a2f98, 0x71374491, 0xb5c0fbcf, 0xe9b5dba5, 0x3956c25b, 0x59f111f1, 0x923f82a4, 0xab1c5ed5,
+  0xd807aa98, 0x12835b01, 0x243185be, 0x550c7dc3, 0x72be5d74, 0x80deb1fe, 0x9bdc06a7, 0xc19bf174,
+  0xe49b69c1, 0xefbe4786, 0x0fc19dc6, 0x240ca1cc, 0x2de92c6f, 0x4a7484aa, 0x5cb0a9dc, 0x76f988da,
+  0x983e5152, 0xa831c66d, 0xb00327c8, 0xbf597fc7, 0xc6e00bf3, 0xd5a79147, 0x06ca6351, 0x14292967,
+  0x27b70a85, 0x2e1b2138, 0x4d2c6dfc, 0x53380d13, 0x650a7354, 0x766a0abb, 0x81c2c92e, 0x92722c85,
+  0xa2bfe8a1, 0xa81a664b, 0xc24b8b70, 0xc76c51a3, 0xd192e819, 0xd6990624, 0xf40e3585, 0x106aa070,
+  0x19a4c116, 0x1e376c08, 0x2748774c, 0x34b0bcb5, 0x391c0cb3, 0x4ed8aa4a, 0x5b9cca4f, 0x682e6ff3,
+  0x748f82ee, 0x78a5636f, 0x84c87814, 0x8cc70208, 0x90befffa, 0xa4506ceb, 0xbef9a3f7, 0xc67178f2,
+}
+
+local function u32be(n)
+  return string.char(band(rshift(n, 24), 0xff), band(rshift(n, 16), 0xff), band(rshift(n, 8), 0xff), band(n, 0xff))
+end
+
+-- pad appends the SHA padding and the big-endian message length in bits.
+local function pad(msg)
+  local len = #msg
+  local zeros = (64 - (len + 9) % 64) % 64
+  local bits = len * 8
+  return msg .. "\128" .. string.rep("\0", zeros) .. u32be(math.floor(bits / 0x100000000)) .. u32be(bits % 0x100000000)
+end
+
+local function read_words(msg, offset, w)
+  for j = 0, 15 do
+    local a, b, c, d = string.byte(msg, offset + j * 4, offset + j * 4 + 3)
+    w[j] = bor(lshift(a, 24), lshift(b, 16), lshift(c, 8), d)
+  end
+end
+
+local function sha256(msg)
+  local h0, h1, h2, h3 = 0x6a09e667, 0xbb67ae85, 0x3c6ef372, 0xa54ff53a
+  local h4, h5, h6, h7 = 0x510e527f, 0x9b05688c, 0x1f83d9ab, 0x5be0cd19
+  local w = {}
+  msg = pad(msg)
+  for i = 1, #msg, 64 do
+    read_words(msg, i, w)
+    for j = 16, 63 do
+      local s0 = bxor(ror(w[j - 15], 7), ror(w[j - 15], 18), rshift(w[j - 15], 3))
+      local s1 = bxor(ror(w[j - 2], 17), ror(w[j - 2], 19), rshift(w[j - 2], 10))
+      w[j] = tobit(w[j - 16] + s0 + w[j - 7] + s1)
+    end
+    local a, b, c, d, e, f, g, h = h0, h1, h2, h3, h4, h5, h6, h7
+    for j = 0, 63 do
+      local s1 = bxor(ror(e, 6), ror(e, 11), ror(e, 25))
+      local ch = bxor(band(e, f), band(bnot(e), g))
+      local t1 = tobit(h + s1 + ch + sha256_k[j + 1] + w[j])
+      local s0 = bxor(ror(a, 2), ror(a, 13), ror(a, 22))
+      local maj = bxor(band(a, b), band(a, c), band(b, c))
+      local t2 = tobit(s0 + maj)
+      h, g, f, e, d, c, b, a = g, f, e, tobit(d + t1), c, b, a, tobit(t1 + t2)
+    end
+    h0, h1, h2, h3 = tobit(h0 + a), tobit(h1 + b), tobit(h2 + c), tobit(h3 + d)
+    h4, h5, h6, h7 = tobit(h4 + e), tobit(h5 + f), tobit(h6 + g), tobit(h7 + h)
+  end
+  return u32be(h0) .. u32be(h1) .. u32be(h2) .. u32be(h3) .. u32be(h4) .. u32be(h5) .. u32be(h6) .. u32be(h7)
+end
+
+local function sha1(msg)
+  local h0, h1, h2, h3, h4 = 0x67452301, 0xefcdab89, 0x98badcfe, 0x10325476, 0xc3d2e1f0
+  local w = {}
+  msg = pad(msg)
+  for i = 1, #msg, 64 do
+    read_words(msg, i, w)
+    for j = 16, 79 do
+      w[j] = rol(bxor(w[j - 3], w[j - 8], w[j - 14], w[j - 16]), 1)
+    end
+    local a, b, c, d, e = h0, h1, h2, h3, h4
+    for j = 0, 79 do
+      local f, k
+      if j < 20 then
+        f, k = bor(band(b, c), band(bnot(b), d)), 0x5a827999
+      elseif j < 40 then
+        f, k = bxor(b, c, d), 0x6ed9eba1
+      elseif j < 60 then
+        f, k = bor(band(b, c), band(b, d), band(c, d)), 0x8f1bbcdc
+      else
+        f, k = bxor(b, c, d), 0xca62c1d6
+      end
+      local t = tobit(rol(a, 5) + f + e + k + w[j])
+      e, d, c, b, a = d, c, rol(b, 30), a, t
+    end
+    h0, h1, h2, h3, h4 = tobit(h0 + a), tobit(h1 + b), tobit(h2 + c), tobit(h3 + d), tobit(h4 + e)
+  end
+  return u32be(h0) .. u32be(h1) .. u32be(h2) .. u32be(h3) .. u32be(h4)
+end
+
+local function hmac(hash, key, msg)
+  if #key > 64 then
+    key = hash(key)
+  end
+  key = key .. string.rep("\0", 64 - #key)
+  local ipad, opad = {}, {}
+  for i = 1, 64 do
+    local b = string.byte(key, i)
+    ipad[i] = string.char(bxor(b, 0x36))
+    opad[i] = string.char(bxor(b, 0x5c))
+  end
+  return hash(table.concat(opad) .. hash(table.concat(ipad) .. msg))
+end
+
+local function to_hex(s)
+  return (s:gsub(".", function(c) return string.format("%02x", string.byte(c)) end))
+end
+
+-- constant_time_equals compares two strings without leaking the position of the
+-- first mismatch through timing.
+local function constant_time_equals(a, b)
+  if #a ~= #b then
+    return false
+  end
+  local diff = 0
+  for i = 1, #a do
+    diff = bor(diff, bxor(string.byte(a, i), string.byte(b, i)))
+  end
+  return diff == 0
+end
+
+local function reject(request_handle, status, message)
+  request_handle:respond({[":status"] = status, ["content-type"] = "text/plain"}, message)
+end
+
+local function from_hex(s)
+  return (s:gsub("..", function(c) return string.char(tonumber(c, 16)) end))
+end
+
+function envoy_on_request(request_handle)
+  local config = request_handle:filterContext()
+  local headers = request_handle:headers()
+
+  local secret = headers:get(config.secretHeader)
+  headers:remove(config.secretHeader)
+  if secret == nil or secret == "" or #secret % 2 ~= 0 or secret:find("[^%x]") then
+    request_handle:logErr("hmac auth: the shared secret is not available")
+    reject(request_handle, "500", "")
+    return
+  end
+  secret = from_hex(secret)
+
+  local signature_prefix = config.signaturePrefix or ""
+  local signature = headers:get(config.signatureHeader)
+  if signature == nil or signature:sub(1, #signature_prefix) ~= signature_prefix then
+    reject(request_handle, "401", "missing or malformed request signature")
+    return
+  end
+  signature = signature:sub(#signature_prefix + 1)
+
+  local payload = ""
+  if config.timestampHeader ~= nil then
+    local timestamp = headers:get(config.timestampHeader)
+    if timestamp == nil or not timestamp:match("^%d+$") then
+      reject(request_handle, "401", "missing or malformed request timestamp")
+      return
+    end
+    if math.abs(os.time() - tonumber(timestamp)) > config.maxSkewSeconds then
+      reject(request_handle, "401", "request timestamp is outside of the allowed window")
+      return
+    end
+    payload = (config.payloadPrefix or "") .. timestamp .. (config.timestampSeparator or ".")
+  end
+
+  local body = request_handle:body()
+  if body ~= nil then
+    payload = payload .. body:getBytes(0, body:length())
+  end
+
+  local hash = sha256
+  if config.algorithm == "SHA1" then
+    hash = sha1
+  end
+  local expected = hmac(hash, secret, payload)
+  if config.encoding == "Base64" then
+    expected = request_handle:base64Escape(expected)
+  else
+    expected = to_hex(expected)
+    signature = signature:lower()
+  end
+
+  if not constant_time_equals(expected, signature) then
+    reject(request_handle, "401", "invalid request signature")
+  end
+end
@@ -0,0 +1,400 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha1" // nolint: gosec
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"hash"
+	"math/bits"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	gopherlua "github.com/yuin/gopher-lua"
+)
+
+// hmacAuthTestHandle is a minimal Lua implementation of the Envoy request handle
+// used by the HMAC auth script. The status of the local reply is saved in the
+// global "status", and the remaining request headers in the global "headers".
+const hmacAuthTestHandle = `
+function new_request_handle(request_headers, body, context)
+  headers = {}
+  status = nil
+  for k, v in pairs(request_headers) do
+    headers[string.lower(k)] = v
+  end
+  local header_map = {
+    get = function(_, k) return headers[string.lower(k)] end,
+    remove = function(_, k) headers[string.lower(k)] = nil end,
+  }
+  local buffer = {
+    length = function(_) return #body end,
+    getBytes = function(_, index, length) return body:sub(index + 1, index + length) end,
+  }
+  return {
+    headers = function(_) return header_map end,
+    body = function(_) return buffer end,
+    filterContext = function(_) return context end,
+    respond = function(_, response_headers, _) status = response_headers[":status"] end,
+    logErr = function(_, _) end,
+    base64Escape = function(_, s) return base64_escape(s) end,
+  }
+end
+`
+
+// luaBitModule implements the subset of the LuaJIT "bit" module that is used by
+// the HMAC auth script. Like LuaJIT, the results are signed 32-bit integers.
+func luaBitModule(l *gopherlua.LState) int {
+	arg := func(i int) uint32 {
+		return uint32(int64(l.CheckNumber(i)))
+	}
+	result := func(n uint32) int {
+		l.Push(gopherlua.LNumber(int32(n)))
+		return 1
+	}
+	fold := func(op func(a, b uint32) uint32) gopherlua.LGFunction {
+		return func(l *gopherlua.LState) int {
+			n := arg(1)
+			for i := 2; i <= l.GetTop(); i++ {
+				n = op(n, arg(i))
+			}
+			return result(n)
+		}
+	}
+
+	l.Push(l.SetFuncs(l.NewTable(), map[string]gopherlua.LGFunction{
+		"band": fold(func(a, b uint32) uint32 { return a & b }),
+		"bor":  fold(func(a, b uint32) uint32 { return a | b }),
+		"bxor": fold(func(a, b uint32) uint32 { return a ^ b }),
+		"bnot": func(l *gopherlua.LState) int { return result(^arg(1)) },
+		"lshift": func(l *gopherlua.LState) int {
+			return result(arg(1) << (arg(2) & 31))
+		},
+		"rshift": func(l *gopherlua.LState) int {
+			return result(arg(1) >> (arg(2) & 31))
+		},
+		"rol": func(l *gopherlua.LState) int {
+			return result(bits.RotateLeft32(arg(1), int(arg(2)&31)))
+		},
+		"ror": func(l *gopherlua.LState) int {
+			return result(bits.RotateLeft32(arg(1), -int(arg(2)&31)))
+		},
+		"tobit": func(l *gopherlua.LState) int { return result(arg(1)) },
+	}))
+	return 1
+}
+
+// runHMACAuthScript runs the HMAC auth script against a request, and returns the
+// status of the local reply, or an empty string if the request is allowed, and
+// the request headers after the script ran.
+func runHMACAuthScript(t *testing.T, secret []byte, headers map[string]string, body string, context map[string]any) (string, map[string]string) {
+	t.Helper()
+
+	l := gopherlua.NewState()
+	defer l.Close()
+	l.PreloadModule("bit", luaBitModule)
+	l.SetGlobal("base64_escape", l.NewFunction(func(l *gopherlua.LState) int {
+		l.Push(gopherlua.LString(base64.StdEncoding.EncodeToString([]byte(l.CheckString(1)))))
+		return 1
+	}))
+	require.NoError(t, l.DoString(hmacAuthScript+luaFailClosedWrapper))
+	require.NoError(t, l.DoString(hmacAuthTestHandle))
+
+	requestHeaders := l.NewTable()
+	for k, v := range headers {
+		requestHeaders.RawSetString(k, gopherlua.LString(v))
+	}
+	if secret != nil {
+		requestHeaders.RawSetString(hmacAuthSecretHeader, gopherlua.LString(hex.EncodeToString(secret)))
+	}
+	filterContext := l.NewTable()
+	for k, v := range context {
+		switch v := v.(type) {
+		case string:
+			filterContext.RawSetString(k, gopherlua.LString(v))
+		case int:
+			filterContext.RawSetString(k, gopherlua.LNumber(v))
+		}
+	}
+
+	require.NoError(t, l.CallByParam(gopherlua.P{Fn: l.GetGlobal("new_request_handle"), NRet: 1, Protect: true},
+		requestHeaders, gopherlua.LString(body), filterContext))
+	handle := l.Get(-1)
+	l.Pop(1)
+	require.NoError(t, l.CallByParam(gopherlua.P{Fn: l.GetGlobal("envoy_on_request"), NRet: 0, Protect: true}, handle))
+
+	remaining := map[string]string{}
+	l.GetGlobal("headers").(*gopherlua.LTable).ForEach(func(k, v gopherlua.LValue) {
+		remaining[k.String()] = v.String()
+	})
+	if status, ok := l.GetGlobal("status").(gopherlua.LString); ok {
+		return string(status), remaining
+	}
+	return "", remaining
+}
+
+func TestHMACAuthScript(t *testing.T) {
+	// Test vectors from RFC 4231 (HMAC-SHA-256) and RFC 2202 (HMAC-SHA-1).
+	// Test case 5 of RFC 4231 is skipped, since its output is truncated.
+	vectors := []struct {
+		name      string
+		algorithm string
+		hash      func() hash.Hash
+		key       []byte
+		data      string
+		want      string
+	}{
+		{
+			name:      "rfc4231 test case 1",
+			algorithm: "SHA256",
+			hash:      sha256.New,
+			key:       bytes.Repeat([]byte{0x0b}, 20),
+			data:      "Hi There",
+			want:      "b0344c61d8db38535ca8afceaf0bf12b881dc200c9833da726e9376c2e32cff7",
+		},
+		{
+			name:      "rfc4231 test case 2",
+			algorithm: "SHA256",
+			hash:      sha256.New,
+			key:       []byte("Jefe"),
+			data:      "what do ya want for nothing?",
+			want:      "5bdcc146bf60754e6a042426089575c75a003f089d2739839dec58b964ec3843",
+		},
+		{
+			name:      "rfc4231 test case 3",
+			algorithm: "SHA256",
+			hash:      sha256.New,
+			key:       bytes.Repeat([]byte{0xaa}, 20),
+			data:      strings.Repeat("\xdd", 50),
+			want:      "773ea91e36800e46854db8ebd09181a72959098b3ef8c122d9635514ced565fe",
+		},
+		{
+			name:      "rfc4231 test case 4",
+			algorithm: "SHA256",
+			hash:      sha256.New,
+			key: []byte{
+				0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08, 0x09, 0x0a, 0x0b, 0x0c, 0x0d,
+				0x0e, 0x0f, 0x10, 0x11, 0x12, 0x13, 0x14, 0x15, 0x16, 0x17, 0x18, 0x19,
+			},
+			data: strings.Repeat("\xcd", 50),
+			want: "82558a389a443c0ea4cc819899f2083a85f0faa3e578f8077a2e3ff46729665b",
+		},
+		{
+			name:      "rfc4231 test case 6",
+			algorithm: "SHA256",
+			hash:      sha256.New,
+			key:       bytes.Repeat([]byte{0xaa}, 131),
+			data:      "Test Using Larger Than Block-Size Key - Hash Key First",
+			want:      "60e431591ee0b67f0d8a26aacbf5b77f8e0bc6213728c5140546040f0ee37f54",
+		},
+		{
+			name:      "rfc4231 test case 7",
+			algorithm: "SHA256",
+			hash:      sha256.New,
+			key:       bytes.Repeat([]byte{0xaa}, 131),
+			data: "This is a test using a larger than block-size key and a larger than block-size data. " +
+				"The key needs to be hashed before being used by the HMAC algorithm.",
+			want: "9b09ffa71b942fcb27635fbcd5b0e944bfdc63644f0713938a7f51535c3a35e2",
+		},
+		{
+			name:      "rfc2202 test case 1",
+			algorithm: "SHA1",
+			hash:      sha1.New,
+			key:       bytes.Repeat([]byte{0x0b}, 20),
+			data:      "Hi There",
+			want:      "b617318655057264e28bc0b6fb378c8ef146be00",
+		},
+		{
+			name:      "rfc2202 test case 2",
+			algorithm: "SHA1",
+			hash:      sha1.New,
+			key:       []byte("Jefe"),
+			data:      "what do ya want for nothing?",
+			want:      "effcdf6ae5eb2fa2d27416d5f184df9c259a7c79",
+		},
+		{
+			name:      "rfc2202 test case 6",
+			algorithm: "SHA1",
+			hash:      sha1.New,
+			key:       bytes.Repeat([]byte{0xaa}, 80),
+			data:      "Test Using Larger Than Block-Size Key - Hash Key First",
+			want:      "aa4ae5e15272d00e95705637ce8a3b55ed402112",
+		},
+	}
+
+	for _, v := range vectors {
+		t.Run(v.name, func(t *testing.T) {
+			mac := hmac.New(v.hash, v.key)
+			mac.Write([]byte(v.data))
+			sum := mac.Sum(nil)
+			require.Equal(t, v.want, hex.EncodeToString(sum))
+
+			hexContext := map[string]any{
+				"secretHeader":    hmacAuthSecretHeader,
+				"signatureHeader": "X-Signature",
+				"signaturePrefix": "sha=",
+				"algorithm":       v.algorithm,
+				"encoding":        "Hex",
+			}
+			status, headers := runHMACAuthScript(t, v.key, map[string]string{"X-Signature": "sha=" + v.want}, v.data, hexContext)
+			require.Empty(t, status)
+			require.NotContains(t, headers, hmacAuthSecretHeader)
+
+			status, _ = runHMACAuthScript(t, v.key, map[string]string{"X-Signature": "sha=" + strings.ToUpper(v.want)}, v.data, hexContext)
+			require.Empty(t, status)
+
+			tampered := v.want[:len(v.want)-1] + "0"
+			if v.want[len(v.want)-1] == '0' {
+				tampered = v.want[:len(v.want)-1] + "1"
+			}
+			status, _ = runHMACAuthScript(t, v.key, map[string]string{"X-Signature": "sha=" + tampered}, v.data, hexContext)
+			require.Equal(t, "401", status)
+
+			base64Context := map[string]any{
+				"secretHeader":    hmacAuthSecretHeader,
+				"signatureHeader": "X-Signature",
+				"algorithm":       v.algorithm,
+				"encoding":        "Base64",
+			}
+			status, _ = runHMACAuthScript(t, v.key, map[string]string{"X-Signature": base64.StdEncoding.EncodeToString(sum)}, v.data, base64Context)
+			require.Empty(t, status)
+		})
+	}
+
+	secret := []byte("webhook-secret")
+	context := map[string]any{
+		"secretHeader":    hmacAuthSecretHeader,
+		"signatureHeader": "X-Signature",
+		"algorithm":       "SHA256",
+		"encoding":        "Hex",
+		"timestampHeader": "X-Timestamp",
+		"maxSkewSeconds":  300,
+	}
+	sign := func(payload string) string {
+		mac := hmac.New(sha256.New, secret)
+		mac.Write([]byte(payload))
+		return hex.EncodeToString(mac.Sum(nil))
+	}
+
+	t.Run("payload layouts", func(t *testing.T) {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		for _, layout := range []struct {
+			name      string
+			context   map[string]any
+			headers   map[string]string
+			signature string
+		}{
+			{
+				name: "github",
+				context: map[string]any{
+					"secretHeader":    hmacAuthSecretHeader,
+					"signatureHeader": "X-Hub-Signature-256",
+					"signaturePrefix": "sha256=",
+					"algorithm":       "SHA256",
+					"encoding":        "Hex",
+				},
+				headers:   map[string]string{"X-Hub-Signature-256": "sha256=" + sign("body")},
+				signature: "X-Hub-Signature-256",
+			},
+			{
+				name: "stripe",
+				context: map[string]any{
+					"secretHeader":       hmacAuthSecretHeader,
+					"signatureHeader":    "X-Signature",
+					"algorithm":          "SHA256",
+					"encoding":           "Hex",
+					"timestampHeader":    "X-Timestamp",
+					"maxSkewSeconds":     300,
+					"timestampSeparator": ".",
+					"payloadPrefix":      "",
+				},
+				headers:   map[string]string{"X-Signature": sign(ts + ".body"), "X-Timestamp": ts},
+				signature: "X-Signature",
+			},
+			{
+				name: "slack",
+				context: map[string]any{
+					"secretHeader":       hmacAuthSecretHeader,
+					"signatureHeader":    "X-Slack-Signature",
+					"signaturePrefix":    "v0=",
+					"algorithm":          "SHA256",
+					"encoding":           "Hex",
+					"timestampHeader":    "X-Slack-Request-Timestamp",
+					"maxSkewSeconds":     300,
+					"timestampSeparator": ":",
+					"payloadPrefix":      "v0:",
+				},
+				headers:   map[string]string{"X-Slack-Signature": "v0=" + sign("v0:"+ts+":body"), "X-Slack-Request-Timestamp": ts},
+				signature: "X-Slack-Signature",
+			},
+		} {
+			t.Run(layout.name, func(t *testing.T) {
+				status, _ := runHMACAuthScript(t, secret, layout.headers, "body", layout.context)
+				require.Empty(t, status)
+
+				// The signature of the body alone, or of another layout, doesn't match.
+				for _, payload := range []string{"body", ts + ".body", "v0:" + ts + ":body", ts + ":body"} {
+					headers := map[string]string{}
+					for k, v := range layout.headers {
+						headers[k] = v
+					}
+					prefix, _ := layout.context["signaturePrefix"].(string)
+					headers[layout.signature] = prefix + sign(payload)
+					if headers[layout.signature] == layout.headers[layout.signature] {
+						continue
+					}
+					status, _ = runHMACAuthScript(t, secret, headers, "body", layout.context)
+					require.Equal(t, "401", status, payload)
+				}
+			})
+		}
+	})
+
+	t.Run("timestamp within the allowed window", func(t *testing.T) {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		status, _ := runHMACAuthScript(t, secret, map[string]string{
+			"X-Signature": sign(ts + ".body"),
+			"X-Timestamp": ts,
+		}, "body", context)
+		require.Empty(t, status)
+	})
+
+	t.Run("timestamp outside of the allowed window", func(t *testing.T) {
+		ts := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+		status, _ := runHMACAuthScript(t, secret, map[string]string{
+			"X-Signature": sign(ts + ".body"),
+			"X-Timestamp": ts,
+		}, "body", context)
+		require.Equal(t, "401", status)
+	})
+
+	t.Run("missing signature", func(t *testing.T) {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		status, _ := runHMACAuthScript(t, secret, map[string]string{"X-Timestamp": ts}, "body", context)
+		require.Equal(t, "401", status)
+	})
+
+	t.Run("missing secret", func(t *testing.T) {
+		ts := strconv.FormatInt(time.Now().Unix(), 10)
+		status, _ := runHMACAuthScript(t, nil, map[string]string{
+			"X-Signature": sign(ts + ".body"),
+			"X-Timestamp": ts,
+		}, "body", context)
+		require.Equal(t, "500", status)
+	})
+
+	t.Run("empty filter context fails closed", func(t *testing.T) {
+		status, _ := runHMACAuthScript(t, secret, map[string]string{"X-Signature": sign("body")}, "body", nil)
+		require.Equal(t, "500", status)
+	})
+}
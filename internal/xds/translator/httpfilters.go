@@ -106,28 +106,70 @@ func newOrderedHTTPFilter(filter *hcmv3.HttpFilter) *OrderedHTTPFilter {
 		order = 1
 	case isFilterType(filter, egv1a1.EnvoyFilterCORS):
 		order = 2
-	case isFilterType(filter, egv1a1.EnvoyFilterExtAuthz):
+	case isFilterType(filter, egv1a1.EnvoyFilterCSRF):
 		order = 3
-	case isFilterType(filter, egv1a1.EnvoyFilterBasicAuth):
+	// The ext_authz decision cache filters are Lua filters placed around the
+	// ext_authz filters.
+	case isExtAuthDecisionCacheFilter(filter):
 		order = 4
-	case isFilterType(filter, egv1a1.EnvoyFilterOAuth2):
+	case isFilterType(filter, egv1a1.EnvoyFilterExtAuthz):
 		order = 5
-	case isFilterType(filter, egv1a1.EnvoyFilterJWTAuthn):
+	case isExtAuthDecisionCacheMarkerFilter(filter):
 		order = 6
-	case isFilterType(filter, egv1a1.EnvoyFilterSessionPersistence):
+	case isFilterType(filter, egv1a1.EnvoyFilterBasicAuth):
 		order = 7
+	case isFilterType(filter, egv1a1.EnvoyFilterAPIKeyAuth):
+		order = 8
+	// The HMAC auth filters are a buffer, a credential injector and a Lua filter,
+	// which must be kept in this order and placed with the other authn filters.
+	case isHMACAuthFilter(filter, egv1a1.EnvoyFilterBuffer):
+		order = 9
+	case isHMACAuthFilter(filter, egv1a1.EnvoyFilterCredentialInjector):
+		order = 10
+	case isHMACAuthFilter(filter, egv1a1.EnvoyFilterLua):
+		order = 11
+	case isFilterType(filter, egv1a1.EnvoyFilterOAuth2):
+		order = 12
+	case isFilterType(filter, egv1a1.EnvoyFilterJWTAuthn):
+		order = 13
+	case isFilterType(filter, egv1a1.EnvoyFilterSessionPersistence):
+		order = 14
 	case isFilterType(filter, egv1a1.EnvoyFilterExtProc):
-		order = 8 + mustGetFilterIndex(filter.Name)
+		order = 15 + mustGetFilterIndex(filter.Name)
 	case isFilterType(filter, egv1a1.EnvoyFilterWasm):
 		order = 100 + mustGetFilterIndex(filter.Name)
+	case isFilterType(filter, egv1a1.EnvoyFilterLua):
+		order = 150 + mustGetFilterIndex(filter.Name)
 	case isFilterType(filter, egv1a1.EnvoyFilterRBAC):
 		order = 201
 	case isFilterType(filter, egv1a1.EnvoyFilterLocalRateLimit):
 		order = 202
 	case isFilterType(filter, egv1a1.EnvoyFilterRateLimit):
 		order = 203
-	case isFilterType(filter, wellknown.Router):
+	case isFilterType(filter, egv1a1.EnvoyFilterBuffer):
 		order = 204
+	case isFilterType(filter, egv1a1.EnvoyFilterBandwidthLimit):
+		order = 205
+	case isFilterType(filter, egv1a1.EnvoyFilterCredentialInjector):
+		order = 206
+	case isFilterType(filter, egv1a1.EnvoyFilterAdmissionControl):
+		order = 207
+	case isFilterType(filter, egv1a1.EnvoyFilterAdaptiveConcurrency):
+		order = 208
+	// The compressor filters are ordered by their position in the Compression
+	// lists, which have at most 3 items.
+	case isFilterType(filter, egv1a1.EnvoyFilterCompressor):
+		order = 209 + mustGetFilterIndex(filter.Name)
+	case isFilterType(filter, egv1a1.EnvoyFilterCache):
+		order = 212
+	// The cache TTL filters must be placed after the cache filters, so that they
+	// update the responses before the cache filters store them.
+	case isCacheTTLFilter(filter):
+		order = 213
+	case isFilterType(filter, egv1a1.EnvoyFilterGRPCJSONTranscoder):
+		order = 214
+	case isFilterType(filter, wellknown.Router):
+		order = 215
 	}
 
 	return &OrderedHTTPFilter{
@@ -294,8 +336,8 @@ func patchRouteWithPerRouteConfig(
 
 	// RateLimit filter is handled separately because it relies on the global
 	// rate limit server configuration.
-	if err := patchRouteWithRateLimit(route.GetRoute(), irRoute); err != nil {
-		return nil
+	if err := patchRouteWithRateLimit(route, irRoute); err != nil {
+		return err
 	}
 
 	return nil
@@ -31,7 +31,9 @@ func Test_sortHTTPFilters(t *testing.T) {
 				httpFilterForTest(egv1a1.EnvoyFilterJWTAuthn),
 				httpFilterForTest(egv1a1.EnvoyFilterOAuth2 + "/securitypolicy/default/policy-for-http-route-1"),
 				httpFilterForTest(egv1a1.EnvoyFilterBasicAuth),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/envoyextensionpolicy/default/policy-for-http-route-1/lua/1"),
 				httpFilterForTest(egv1a1.EnvoyFilterWasm + "/envoyextensionpolicy/default/policy-for-http-route-1/2"),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/envoyextensionpolicy/default/policy-for-http-route-1/lua/0"),
 				httpFilterForTest(egv1a1.EnvoyFilterRateLimit),
 				httpFilterForTest(egv1a1.EnvoyFilterExtProc + "/envoyextensionpolicy/default/policy-for-http-route-1/1"),
 				httpFilterForTest(egv1a1.EnvoyFilterFault),
@@ -41,14 +43,34 @@ func Test_sortHTTPFilters(t *testing.T) {
 				httpFilterForTest(egv1a1.EnvoyFilterLocalRateLimit),
 				httpFilterForTest(egv1a1.EnvoyFilterWasm + "/envoyextensionpolicy/default/policy-for-http-route-1/1"),
 				httpFilterForTest(egv1a1.EnvoyFilterRBAC + "/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterAPIKeyAuth),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/hmac_auth"),
+				httpFilterForTest(egv1a1.EnvoyFilterCSRF),
+				httpFilterForTest(egv1a1.EnvoyFilterCredentialInjector + "/hmac_auth/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterBuffer + "/hmac_auth"),
+				httpFilterForTest(egv1a1.EnvoyFilterAdaptiveConcurrency + "/httproute/default/httproute-1/rule/0/match/0/www_example_com"),
+				httpFilterForTest(egv1a1.EnvoyFilterBandwidthLimit),
+				httpFilterForTest(egv1a1.EnvoyFilterBuffer),
+				httpFilterForTest(egv1a1.EnvoyFilterCredentialInjector + "/httproutefilter/default/credential-injection"),
+				httpFilterForTest(egv1a1.EnvoyFilterGRPCJSONTranscoder + "/envoyextensionpolicy/default/policy-for-http-route-1"),
+				httpFilterForTest("envoy.filters.http.header_mutation/cache_ttl/60"),
+				httpFilterForTest(egv1a1.EnvoyFilterCache + "/httproute/default/httproute-1/rule/0/match/0/www_example_com"),
+				httpFilterForTest(egv1a1.EnvoyFilterCompressor + "/gzip/e1908aa/1"),
+				httpFilterForTest(egv1a1.EnvoyFilterCompressor + "/brotli/d8dbbd6b/0"),
+				httpFilterForTest(egv1a1.EnvoyFilterAdmissionControl + "/httproute/default/httproute-1/rule/0/match/0/www_example_com"),
 				httpFilterForTest(wellknown.HealthCheck),
 			},
 			want: []*hcmv3.HttpFilter{
 				httpFilterForTest(wellknown.HealthCheck),
 				httpFilterForTest(egv1a1.EnvoyFilterFault),
 				httpFilterForTest(egv1a1.EnvoyFilterCORS),
+				httpFilterForTest(egv1a1.EnvoyFilterCSRF),
 				httpFilterForTest(egv1a1.EnvoyFilterExtAuthz + "/securitypolicy/default/policy-for-http-route-1"),
 				httpFilterForTest(egv1a1.EnvoyFilterBasicAuth),
+				httpFilterForTest(egv1a1.EnvoyFilterAPIKeyAuth),
+				httpFilterForTest(egv1a1.EnvoyFilterBuffer + "/hmac_auth"),
+				httpFilterForTest(egv1a1.EnvoyFilterCredentialInjector + "/hmac_auth/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/hmac_auth"),
 				httpFilterForTest(egv1a1.EnvoyFilterOAuth2 + "/securitypolicy/default/policy-for-http-route-1"),
 				httpFilterForTest(egv1a1.EnvoyFilterJWTAuthn),
 				httpFilterForTest(egv1a1.EnvoyFilterExtProc + "/envoyextensionpolicy/default/policy-for-http-route-1/0"),
@@ -56,9 +78,50 @@ func Test_sortHTTPFilters(t *testing.T) {
 				httpFilterForTest(egv1a1.EnvoyFilterWasm + "/envoyextensionpolicy/default/policy-for-http-route-1/0"),
 				httpFilterForTest(egv1a1.EnvoyFilterWasm + "/envoyextensionpolicy/default/policy-for-http-route-1/1"),
 				httpFilterForTest(egv1a1.EnvoyFilterWasm + "/envoyextensionpolicy/default/policy-for-http-route-1/2"),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/envoyextensionpolicy/default/policy-for-http-route-1/lua/0"),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/envoyextensionpolicy/default/policy-for-http-route-1/lua/1"),
 				httpFilterForTest(egv1a1.EnvoyFilterRBAC + "/securitypolicy/default/policy-for-http-route-1"),
 				httpFilterForTest(egv1a1.EnvoyFilterLocalRateLimit),
 				httpFilterForTest(egv1a1.EnvoyFilterRateLimit),
+				httpFilterForTest(egv1a1.EnvoyFilterBuffer),
+				httpFilterForTest(egv1a1.EnvoyFilterBandwidthLimit),
+				httpFilterForTest(egv1a1.EnvoyFilterCredentialInjector + "/httproutefilter/default/credential-injection"),
+				httpFilterForTest(egv1a1.EnvoyFilterAdmissionControl + "/httproute/default/httproute-1/rule/0/match/0/www_example_com"),
+				httpFilterForTest(egv1a1.EnvoyFilterAdaptiveConcurrency + "/httproute/default/httproute-1/rule/0/match/0/www_example_com"),
+				httpFilterForTest(egv1a1.EnvoyFilterCompressor + "/brotli/d8dbbd6b/0"),
+				httpFilterForTest(egv1a1.EnvoyFilterCompressor + "/gzip/e1908aa/1"),
+				httpFilterForTest(egv1a1.EnvoyFilterCache + "/httproute/default/httproute-1/rule/0/match/0/www_example_com"),
+				httpFilterForTest("envoy.filters.http.header_mutation/cache_ttl/60"),
+				httpFilterForTest(egv1a1.EnvoyFilterGRPCJSONTranscoder + "/envoyextensionpolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterRouter),
+			},
+		},
+		{
+			name: "sort filters with ext_authz decision cache",
+			filters: []*hcmv3.HttpFilter{
+				httpFilterForTest(egv1a1.EnvoyFilterRouter),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/envoyextensionpolicy/default/policy-for-http-route-1/lua/0"),
+				httpFilterForTest(egv1a1.EnvoyFilterRBAC + "/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterJWTAuthn),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/hmac_auth"),
+				httpFilterForTest(egv1a1.EnvoyFilterBasicAuth),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/ext_authz_decision_cache_marker/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterExtAuthz + "/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/ext_authz_decision_cache/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterCORS),
+				httpFilterForTest(wellknown.HealthCheck),
+			},
+			want: []*hcmv3.HttpFilter{
+				httpFilterForTest(wellknown.HealthCheck),
+				httpFilterForTest(egv1a1.EnvoyFilterCORS),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/ext_authz_decision_cache/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterExtAuthz + "/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/ext_authz_decision_cache_marker/securitypolicy/default/policy-for-http-route-1"),
+				httpFilterForTest(egv1a1.EnvoyFilterBasicAuth),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/hmac_auth"),
+				httpFilterForTest(egv1a1.EnvoyFilterJWTAuthn),
+				httpFilterForTest(egv1a1.EnvoyFilterLua + "/envoyextensionpolicy/default/policy-for-http-route-1/lua/0"),
+				httpFilterForTest(egv1a1.EnvoyFilterRBAC + "/securitypolicy/default/policy-for-http-route-1"),
 				httpFilterForTest(egv1a1.EnvoyFilterRouter),
 			},
 		},
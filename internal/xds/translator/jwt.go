@@ -103,26 +103,6 @@ func buildJWTAuthn(irListener *ir.HTTPListener) (*jwtauthnv3.JwtAuthentication,
 		var reqs []*jwtauthnv3.JwtRequirement
 		for i := range route.Security.JWT.Providers {
 			irProvider := route.Security.JWT.Providers[i]
-			// Create the cluster for the remote jwks, if it doesn't exist.
-			jwksCluster, err := url2Cluster(irProvider.RemoteJWKS.URI)
-			if err != nil {
-				return nil, err
-			}
-
-			remote := &jwtauthnv3.JwtProvider_RemoteJwks{
-				RemoteJwks: &jwtauthnv3.RemoteJwks{
-					HttpUri: &corev3.HttpUri{
-						Uri: irProvider.RemoteJWKS.URI,
-						HttpUpstreamType: &corev3.HttpUri_Cluster{
-							Cluster: jwksCluster.name,
-						},
-						Timeout: &durationpb.Duration{Seconds: defaultExtServiceRequestTimeout},
-					},
-					CacheDuration: &durationpb.Duration{Seconds: 5 * 60},
-					AsyncFetch:    &jwtauthnv3.JwksAsyncFetch{},
-				},
-			}
-
 			claimToHeaders := []*jwtauthnv3.JwtClaimToHeader{}
 			for _, claimToHeader := range irProvider.ClaimToHeaders {
 				claimToHeader := &jwtauthnv3.JwtClaimToHeader{
@@ -132,18 +112,47 @@ func buildJWTAuthn(irListener *ir.HTTPListener) (*jwtauthnv3.JwtAuthentication,
 				claimToHeaders = append(claimToHeaders, claimToHeader)
 			}
 			jwtProvider := &jwtauthnv3.JwtProvider{
-				Issuer:              irProvider.Issuer,
-				Audiences:           irProvider.Audiences,
-				JwksSourceSpecifier: remote,
-				PayloadInMetadata:   irProvider.Name,
-				ClaimToHeaders:      claimToHeaders,
-				Forward:             true,
+				Issuer:            irProvider.Issuer,
+				Audiences:         irProvider.Audiences,
+				PayloadInMetadata: irProvider.Name,
+				ClaimToHeaders:    claimToHeaders,
+				Forward:           true,
 				NormalizePayloadInMetadata: &jwtauthnv3.JwtProvider_NormalizePayload{
 					// Normalize the scopes to facilitate matching in Authorization.
 					SpaceDelimitedClaims: []string{"scope"},
 				},
 			}
 
+			if irProvider.LocalJWKS != nil {
+				jwtProvider.JwksSourceSpecifier = &jwtauthnv3.JwtProvider_LocalJwks{
+					LocalJwks: &corev3.DataSource{
+						Specifier: &corev3.DataSource_InlineString{
+							InlineString: ptr.Deref(irProvider.LocalJWKS.Inline, ""),
+						},
+					},
+				}
+			} else {
+				// Create the cluster for the remote jwks, if it doesn't exist.
+				jwksCluster, err := url2Cluster(irProvider.RemoteJWKS.URI)
+				if err != nil {
+					return nil, err
+				}
+
+				jwtProvider.JwksSourceSpecifier = &jwtauthnv3.JwtProvider_RemoteJwks{
+					RemoteJwks: &jwtauthnv3.RemoteJwks{
+						HttpUri: &corev3.HttpUri{
+							Uri: irProvider.RemoteJWKS.URI,
+							HttpUpstreamType: &corev3.HttpUri_Cluster{
+								Cluster: jwksCluster.name,
+							},
+							Timeout: &durationpb.Duration{Seconds: defaultExtServiceRequestTimeout},
+						},
+						CacheDuration: &durationpb.Duration{Seconds: 5 * 60},
+						AsyncFetch:    &jwtauthnv3.JwksAsyncFetch{},
+					},
+				}
+			}
+
 			if irProvider.RecomputeRoute != nil {
 				jwtProvider.ClearRouteCache = *irProvider.RecomputeRoute
 			}
@@ -272,6 +281,9 @@ func (*jwt) patchResources(tCtx *types.ResourceVersionTable, routes []*ir.HTTPRo
 
 		for i := range route.Security.JWT.Providers {
 			provider := route.Security.JWT.Providers[i]
+			if provider.RemoteJWKS == nil {
+				continue
+			}
 
 			if err = addClusterFromURL(provider.RemoteJWKS.URI, tCtx); err != nil {
 				errs = errors.Join(errs, err)
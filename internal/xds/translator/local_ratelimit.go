@@ -161,7 +161,7 @@ func (*localRateLimit) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) e
 		},
 		FilterEnforced: &configv3.RuntimeFractionalPercent{
 			DefaultValue: &typev3.FractionalPercent{
-				Numerator:   100,
+				Numerator:   localRateLimitEnforcedPercent(local),
 				Denominator: typev3.FractionalPercent_HUNDRED,
 			},
 		},
@@ -188,6 +188,20 @@ func (*localRateLimit) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) e
 	return nil
 }
 
+// localRateLimitEnforcedPercent returns the percentage of requests for which
+// the local rate limit is enforced.
+// In shadow mode, the filter still counts the requests and emits statistics,
+// but the limits are never enforced.
+// The "x-ratelimit-*" headers are not enabled on the local rate limit filter,
+// since the per-route config can't honor the DisableRateLimitHeaders setting
+// of the listener. Only the global rate limit filter adds them.
+func localRateLimitEnforcedPercent(local *ir.LocalRateLimit) uint32 {
+	if local.ShadowMode {
+		return 0
+	}
+	return 100
+}
+
 func buildRouteLocalRateLimits(local *ir.LocalRateLimit) (
 	[]*routev3.RateLimit, []*rlv3.LocalRateLimitDescriptor, error,
 ) {
@@ -242,6 +256,22 @@ func buildRouteLocalRateLimits(local *ir.LocalRateLimit) (
 			descriptorEntries = append(descriptorEntries, entry)
 		}
 
+		// Method, Path, QueryParamMatches and JWTClaimMatches
+		for _, match := range buildRateLimitRuleMatches(rIdx, rule) {
+			// This is a sanity check. This should never happen because Gateway
+			// API translator should have already validated this.
+			if match.distinct {
+				return nil, nil, errors.New("local rateLimit does not support distinct matches")
+			}
+
+			entry := &rlv3.RateLimitDescriptor_Entry{
+				Key:   match.descriptorKey,
+				Value: match.descriptorValue,
+			}
+			rlActions = append(rlActions, match.action)
+			descriptorEntries = append(descriptorEntries, entry)
+		}
+
 		// Source IP CIDRMatch
 		if rule.CIDRMatch != nil {
 			// This is a sanity check. This should never happen because Gateway
@@ -0,0 +1,166 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"errors"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
+	luafilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/lua/v3"
+	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
+	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
+	"github.com/envoyproxy/gateway/internal/xds/types"
+)
+
+// luaFailClosedWrapper is appended to the Lua scripts that don't fail open.
+// It wraps the request and response handlers of the script, so the errors raised
+// while processing the request are logged and the request is rejected with a 500.
+// The errors raised while processing the response are logged and the response is
+// replaced with an empty 500 response, unless it has already been sent downstream.
+// Envoy's default behavior is to log the error and continue the filter chain.
+const luaFailClosedWrapper = `
+-- Added by Envoy Gateway: reject the request if the script fails.
+do
+  local envoy_gateway_on_request = envoy_on_request
+  if envoy_gateway_on_request ~= nil then
+    envoy_on_request = function(request_handle)
+      local ok, err = pcall(envoy_gateway_on_request, request_handle)
+      if not ok then
+        request_handle:logErr(tostring(err))
+        request_handle:respond({[":status"] = "500"}, "")
+      end
+    end
+  end
+  local envoy_gateway_on_response = envoy_on_response
+  if envoy_gateway_on_response ~= nil then
+    envoy_on_response = function(response_handle)
+      local ok, err = pcall(envoy_gateway_on_response, response_handle)
+      if not ok then
+        response_handle:logErr(tostring(err))
+        pcall(function()
+          response_handle:headers():replace(":status", "500")
+          response_handle:body(true):setBytes("")
+        end)
+      end
+    end
+  end
+end
+`
+
+func init() {
+	registerHTTPFilter(&lua{})
+}
+
+type lua struct{}
+
+var _ httpFilter = &lua{}
+
+// patchHCM builds and appends the Lua Filters to the HTTP Connection Manager
+// if applicable, and it does not already exist.
+// Note: this method creates a Lua filter for each route that contains a Lua config.
+// The filter is disabled by default. It is enabled on the route level.
+func (*lua) patchHCM(mgr *hcmv3.HttpConnectionManager, irListener *ir.HTTPListener) error {
+	var errs error
+
+	if mgr == nil {
+		return errors.New("hcm is nil")
+	}
+	if irListener == nil {
+		return errors.New("ir listener is nil")
+	}
+
+	for _, route := range irListener.Routes {
+		if !routeContainsLua(route) {
+			continue
+		}
+		for _, l := range route.EnvoyExtensions.Luas {
+			if hcmContainsFilter(mgr, luaFilterName(l)) {
+				continue
+			}
+			filter, err := buildHCMLuaFilter(l)
+			if err != nil {
+				errs = errors.Join(errs, err)
+				continue
+			}
+			mgr.HttpFilters = append(mgr.HttpFilters, filter)
+		}
+	}
+
+	return errs
+}
+
+// buildHCMLuaFilter returns a Lua HTTP filter from the provided IR Lua.
+func buildHCMLuaFilter(l ir.Lua) (*hcmv3.HttpFilter, error) {
+	code := l.Code
+	if !l.FailOpen {
+		code += luaFailClosedWrapper
+	}
+
+	luaProto := &luafilterv3.Lua{
+		DefaultSourceCode: &corev3.DataSource{
+			Specifier: &corev3.DataSource_InlineString{
+				InlineString: code,
+			},
+		},
+	}
+
+	luaAny, err := protocov.ToAnyWithValidation(luaProto)
+	if err != nil {
+		return nil, err
+	}
+
+	// All Lua filters for all Routes are aggregated on HCM and disabled by default
+	// Per-route config is used to enable the relevant filters on appropriate routes
+	return &hcmv3.HttpFilter{
+		Name:     luaFilterName(l),
+		Disabled: true,
+		ConfigType: &hcmv3.HttpFilter_TypedConfig{
+			TypedConfig: luaAny,
+		},
+	}, nil
+}
+
+func luaFilterName(l ir.Lua) string {
+	return perRouteFilterName(egv1a1.EnvoyFilterLua, l.Name)
+}
+
+// routeContainsLua returns true if Luas exists for the provided route.
+func routeContainsLua(irRoute *ir.HTTPRoute) bool {
+	if irRoute == nil {
+		return false
+	}
+
+	return irRoute.EnvoyExtensions != nil && len(irRoute.EnvoyExtensions.Luas) > 0
+}
+
+func (*lua) patchResources(*types.ResourceVersionTable, []*ir.HTTPRoute) error {
+	return nil
+}
+
+// patchRoute patches the provided route with the Lua config if applicable.
+// Note: this method enables the corresponding Lua filter for the provided route.
+func (*lua) patchRoute(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+	if irRoute == nil {
+		return errors.New("ir route is nil")
+	}
+	if irRoute.EnvoyExtensions == nil {
+		return nil
+	}
+
+	for _, l := range irRoute.EnvoyExtensions.Luas {
+		if err := enableFilterOnRoute(route, luaFilterName(l)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
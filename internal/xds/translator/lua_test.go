@@ -0,0 +1,100 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+package translator
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	gopherlua "github.com/yuin/gopher-lua"
+)
+
+// luaFailClosedTestHandle is a minimal Lua implementation of the Envoy request
+// and response handles used by the fail closed wrapper. The local reply status
+// is saved in the global "local_reply", and the response in the globals "status"
+// and "body".
+const luaFailClosedTestHandle = `
+local_reply = nil
+status = "200"
+body = "response body"
+handle = {
+  logErr = function(_, _) end,
+  respond = function(_, response_headers, _) local_reply = response_headers[":status"] end,
+  headers = function(_)
+    return {
+      replace = function(_, k, v) if k == ":status" then status = v end end,
+    }
+  end,
+  body = function(_, _)
+    return {
+      setBytes = function(_, b) body = b end,
+    }
+  end,
+}
+`
+
+func TestLuaFailClosedWrapper(t *testing.T) {
+	testCases := []struct {
+		name             string
+		script           string
+		handler          string
+		expectLocalReply gopherlua.LValue
+		expectStatus     string
+		expectBody       string
+	}{
+		{
+			name:             "request succeeds",
+			script:           `function envoy_on_request(request_handle) end`,
+			handler:          "envoy_on_request",
+			expectLocalReply: gopherlua.LNil,
+			expectStatus:     "200",
+			expectBody:       "response body",
+		},
+		{
+			name:             "request fails",
+			script:           `function envoy_on_request(request_handle) error("boom") end`,
+			handler:          "envoy_on_request",
+			expectLocalReply: gopherlua.LString("500"),
+			expectStatus:     "200",
+			expectBody:       "response body",
+		},
+		{
+			name:             "response succeeds",
+			script:           `function envoy_on_response(response_handle) end`,
+			handler:          "envoy_on_response",
+			expectLocalReply: gopherlua.LNil,
+			expectStatus:     "200",
+			expectBody:       "response body",
+		},
+		{
+			name:             "response fails",
+			script:           `function envoy_on_response(response_handle) error("boom") end`,
+			handler:          "envoy_on_response",
+			expectLocalReply: gopherlua.LNil,
+			expectStatus:     "500",
+			expectBody:       "",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			l := gopherlua.NewState()
+			defer l.Close()
+
+			require.NoError(t, l.DoString(luaFailClosedTestHandle))
+			require.NoError(t, l.DoString(tc.script+luaFailClosedWrapper))
+			require.NoError(t, l.CallByParam(gopherlua.P{
+				Fn:      l.GetGlobal(tc.handler),
+				NRet:    0,
+				Protect: true,
+			}, l.GetGlobal("handle")))
+
+			require.Equal(t, tc.expectLocalReply, l.GetGlobal("local_reply"))
+			require.Equal(t, gopherlua.LString(tc.expectStatus), l.GetGlobal("status"))
+			require.Equal(t, gopherlua.LString(tc.expectBody), l.GetGlobal("body"))
+		})
+	}
+}
@@ -17,6 +17,7 @@ import (
 	ratelimitfilterv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/http/ratelimit/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
 	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	metadatav3 "github.com/envoyproxy/go-control-plane/envoy/type/metadata/v3"
 	"github.com/envoyproxy/go-control-plane/pkg/wellknown"
 	rlsconfv3 "github.com/envoyproxy/go-control-plane/ratelimit/config/ratelimit/v3"
 	"github.com/envoyproxy/ratelimit/src/config"
@@ -26,6 +27,7 @@ import (
 	goyaml "gopkg.in/yaml.v3" // nolint: depguard
 	"k8s.io/utils/ptr"
 
+	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
 	"github.com/envoyproxy/gateway/internal/xds/types"
 )
@@ -127,17 +129,59 @@ func (t *Translator) buildRateLimitFilter(irListener *ir.HTTPListener) *hcmv3.Ht
 }
 
 // patchRouteWithRateLimit builds rate limit actions and appends to the route.
-func patchRouteWithRateLimit(xdsRouteAction *routev3.RouteAction, irRoute *ir.HTTPRoute) error { //nolint:unparam
+func patchRouteWithRateLimit(route *routev3.Route, irRoute *ir.HTTPRoute) error {
+	xdsRouteAction := route.GetRoute()
 	// Return early if no rate limit config exists.
 	if !routeContainsGlobalRateLimit(irRoute) || xdsRouteAction == nil {
 		return nil
 	}
 
-	rateLimits := buildRouteRateLimits(irRoute.Name, irRoute.Traffic.RateLimit.Global)
-	xdsRouteAction.RateLimits = rateLimits
+	global := irRoute.Traffic.RateLimit.Global
+	rateLimits := buildRouteRateLimits(irRoute.Name, global)
+
+	// The hits addend of the rate limits is only supported when the rate limits
+	// are configured in the per-route filter config, so the rate limits with a
+	// cost are added to the per-route filter config instead of the route action.
+	if !globalRateLimitContainsCost(global) {
+		xdsRouteAction.RateLimits = rateLimits
+		return nil
+	}
+
+	rateLimitPerRouteAny, err := anypb.New(&ratelimitfilterv3.RateLimitPerRoute{
+		RateLimits: rateLimits,
+	})
+	if err != nil {
+		return err
+	}
+	if route.TypedPerFilterConfig == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[wellknown.HTTPRateLimit] = rateLimitPerRouteAny
 	return nil
 }
 
+// globalRateLimitContainsCost returns true if any rule of the global rate limit
+// has a request or response cost.
+func globalRateLimitContainsCost(global *ir.GlobalRateLimit) bool {
+	for _, rule := range global.Rules {
+		if rule.RequestCost != nil || rule.ResponseCost != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// buildRateLimitHitsAddend returns the hits addend for the provided rate limit cost.
+func buildRateLimitHitsAddend(cost *ir.RateLimitCost) *routev3.RateLimit_HitsAddend {
+	hitsAddend := &routev3.RateLimit_HitsAddend{}
+	if cost.Number != nil {
+		hitsAddend.Number = wrapperspb.UInt64(*cost.Number)
+	} else if cost.Format != nil {
+		hitsAddend.Format = *cost.Format
+	}
+	return hitsAddend
+}
+
 func buildRouteRateLimits(descriptorPrefix string, global *ir.GlobalRateLimit) []*routev3.RateLimit {
 	var rateLimits []*routev3.RateLimit
 
@@ -199,6 +243,11 @@ func buildRouteRateLimits(descriptorPrefix string, global *ir.GlobalRateLimit) [
 			rlActions = append(rlActions, action)
 		}
 
+		// Setup the actions for the method, path, query parameter and JWT claim matches
+		for _, match := range buildRateLimitRuleMatches(rIdx, rule) {
+			rlActions = append(rlActions, match.action)
+		}
+
 		// To be able to rate limit each individual IP, we need to use a nested descriptors structure in the configuration
 		// of the rate limit server:
 		// * the outer layer is a masked_remote_address descriptor that catches all the source IPs inside a specified CIDR.
@@ -260,7 +309,21 @@ func buildRouteRateLimits(descriptorPrefix string, global *ir.GlobalRateLimit) [
 		}
 
 		rateLimit := &routev3.RateLimit{Actions: rlActions}
+		if rule.RequestCost != nil {
+			rateLimit.HitsAddend = buildRateLimitHitsAddend(rule.RequestCost)
+		}
 		rateLimits = append(rateLimits, rateLimit)
+
+		// The response cost is added to the counters of the same descriptors
+		// after the response is completed. It doesn't reject the current request,
+		// but the subsequent requests once the limit is reached.
+		if rule.ResponseCost != nil {
+			rateLimits = append(rateLimits, &routev3.RateLimit{
+				Actions:           rlActions,
+				HitsAddend:        buildRateLimitHitsAddend(rule.ResponseCost),
+				ApplyOnStreamDone: true,
+			})
+		}
 	}
 
 	return rateLimits
@@ -335,8 +398,9 @@ func buildRateLimitServiceDescriptors(global *ir.GlobalRateLimit) []*rlsconfv3.R
 	// The order in which matching descriptors are built is consistent with
 	// the order in which ratelimit actions are built:
 	//  1) Header Matches
-	//  2) CIDR Match
-	//  3) No Match
+	//  2) Method, Path, Query Parameter and JWT Claim Matches
+	//  3) CIDR Match
+	//  4) No Match
 	for rIdx, rule := range global.Rules {
 		rateLimitPolicy := &rlsconfv3.RateLimitPolicy{
 			RequestsPerUnit: uint32(rule.Limit.Requests),
@@ -371,6 +435,22 @@ func buildRateLimitServiceDescriptors(global *ir.GlobalRateLimit) []*rlsconfv3.R
 			// as it is also possible that CIDR match descriptor also exist.
 		}
 
+		for _, match := range buildRateLimitRuleMatches(rIdx, rule) {
+			// For distinct matches, only the key is set so that each unique value
+			// of the request attribute has its own rate limit bucket.
+			pbDesc := &rlsconfv3.RateLimitDescriptor{
+				Key:   match.descriptorKey,
+				Value: match.descriptorValue,
+			}
+
+			if cur != nil {
+				cur.Descriptors = []*rlsconfv3.RateLimitDescriptor{pbDesc}
+			} else {
+				head = pbDesc
+			}
+			cur = pbDesc
+		}
+
 		// EG supports two kinds of rate limit descriptors for the source IP: exact and distinct.
 		// * exact means that all IP Addresses within the specified Source IP CIDR share the same rate limit bucket.
 		// * distinct means that each IP Address within the specified Source IP CIDR has its own rate limit bucket.
@@ -428,12 +508,168 @@ func buildRateLimitServiceDescriptors(global *ir.GlobalRateLimit) []*rlsconfv3.R
 
 		// Add the ratelimit policy to the last descriptor of chain.
 		cur.RateLimit = rateLimitPolicy
+		// In shadow mode, the rate limit service counts the requests and emits
+		// statistics, but always returns OK.
+		cur.ShadowMode = global.ShadowMode
 		pbDescriptors = append(pbDescriptors, head)
 	}
 
 	return pbDescriptors
 }
 
+// rateLimitMatch holds the rate limit action built for a match of a rate limit
+// rule, and the descriptor entry that the action generates for the matching requests.
+type rateLimitMatch struct {
+	action *routev3.RateLimit_Action
+	// descriptorKey is the key of the descriptor entry.
+	descriptorKey string
+	// descriptorValue is the value of the descriptor entry. It's empty for the
+	// distinct matches, as the value is the one of the request attribute.
+	descriptorValue string
+	// distinct means that each value of the request attribute uses a separate
+	// rate limit bucket.
+	distinct bool
+}
+
+// buildRateLimitRuleMatches builds the rate limit actions for the method, path,
+// query parameter and JWT claim matches of the provided rule.
+// The match index continues from the header matches, so the descriptors of the
+// header matches don't change.
+func buildRateLimitRuleMatches(rIdx int, rule *ir.RateLimitRule) []*rateLimitMatch {
+	var (
+		matches []*rateLimitMatch
+		mIdx    = len(rule.HeaderMatches)
+	)
+
+	if len(rule.MethodMatches) > 0 {
+		methodMatch := &ir.StringMatch{Exact: ptr.To(rule.MethodMatches[0])}
+		if len(rule.MethodMatches) > 1 {
+			methodMatch = &ir.StringMatch{SafeRegex: ptr.To(strings.Join(rule.MethodMatches, "|"))}
+		}
+		matches = append(matches, buildRateLimitHeaderValueMatch(rIdx, mIdx, ":method", methodMatch))
+		mIdx++
+	}
+
+	if rule.PathMatch != nil {
+		pathMatch := &ir.StringMatch{SafeRegex: ptr.To(pathRegex(rule.PathMatch))}
+		matches = append(matches, buildRateLimitHeaderValueMatch(rIdx, mIdx, ":path", pathMatch))
+		mIdx++
+	}
+
+	for _, match := range rule.QueryParamMatches {
+		descriptorKey := getRouteRuleDescriptor(rIdx, mIdx)
+		if match.Distinct {
+			// Setup QueryParameters actions
+			matches = append(matches, &rateLimitMatch{
+				action: &routev3.RateLimit_Action{
+					ActionSpecifier: &routev3.RateLimit_Action_QueryParameters_{
+						QueryParameters: &routev3.RateLimit_Action_QueryParameters{
+							QueryParameterName: match.Name,
+							DescriptorKey:      descriptorKey,
+						},
+					},
+				},
+				descriptorKey: descriptorKey,
+				distinct:      true,
+			})
+		} else {
+			// Setup QueryParameterValueMatch actions
+			descriptorVal := getRouteRuleDescriptor(rIdx, mIdx)
+			queryParamMatcher := &routev3.QueryParameterMatcher{
+				Name: match.Name,
+				QueryParameterMatchSpecifier: &routev3.QueryParameterMatcher_StringMatch{
+					StringMatch: buildXdsStringMatcher(match),
+				},
+			}
+			matches = append(matches, &rateLimitMatch{
+				action: &routev3.RateLimit_Action{
+					ActionSpecifier: &routev3.RateLimit_Action_QueryParameterValueMatch_{
+						QueryParameterValueMatch: &routev3.RateLimit_Action_QueryParameterValueMatch{
+							DescriptorKey:   descriptorKey,
+							DescriptorValue: descriptorVal,
+							ExpectMatch: &wrapperspb.BoolValue{
+								Value: match.Invert == nil || !*match.Invert,
+							},
+							QueryParameters: []*routev3.QueryParameterMatcher{queryParamMatcher},
+						},
+					},
+				},
+				descriptorKey:   descriptorKey,
+				descriptorValue: descriptorVal,
+			})
+		}
+		mIdx++
+	}
+
+	// The JWT claims are read from the dynamic metadata emitted by the JWT authn
+	// filter, which stores the payload of the verified token under the name of
+	// the provider.
+	// The action generates a descriptor entry with the claim value, so the
+	// descriptor value of the exact matches is the expected claim value.
+	// Requests without the claim don't generate the descriptor, and are not
+	// rate limited by the rule.
+	for _, match := range rule.JWTClaimMatches {
+		descriptorKey := getRouteRuleDescriptor(rIdx, mIdx)
+		path := make([]*metadatav3.MetadataKey_PathSegment, 0, len(match.Claim)+1)
+		for _, segment := range append([]string{match.Provider}, match.Claim...) {
+			path = append(path, &metadatav3.MetadataKey_PathSegment{
+				Segment: &metadatav3.MetadataKey_PathSegment_Key{
+					Key: segment,
+				},
+			})
+		}
+		matches = append(matches, &rateLimitMatch{
+			action: &routev3.RateLimit_Action{
+				ActionSpecifier: &routev3.RateLimit_Action_Metadata{
+					Metadata: &routev3.RateLimit_Action_MetaData{
+						DescriptorKey: descriptorKey,
+						MetadataKey: &metadatav3.MetadataKey{
+							Key:  egv1a1.EnvoyFilterJWTAuthn.String(),
+							Path: path,
+						},
+						Source: routev3.RateLimit_Action_MetaData_DYNAMIC,
+					},
+				},
+			},
+			descriptorKey:   descriptorKey,
+			descriptorValue: ptr.Deref(match.Value, ""),
+			distinct:        match.Distinct,
+		})
+		mIdx++
+	}
+
+	return matches
+}
+
+// buildRateLimitHeaderValueMatch builds a HeaderValueMatch rate limit action for
+// the provided header, which can also be a pseudo-header like ":path".
+func buildRateLimitHeaderValueMatch(rIdx, mIdx int, name string, match *ir.StringMatch) *rateLimitMatch {
+	descriptorKey := getRouteRuleDescriptor(rIdx, mIdx)
+	descriptorVal := getRouteRuleDescriptor(rIdx, mIdx)
+	headerMatcher := &routev3.HeaderMatcher{
+		Name: name,
+		HeaderMatchSpecifier: &routev3.HeaderMatcher_StringMatch{
+			StringMatch: buildXdsStringMatcher(match),
+		},
+	}
+	return &rateLimitMatch{
+		action: &routev3.RateLimit_Action{
+			ActionSpecifier: &routev3.RateLimit_Action_HeaderValueMatch_{
+				HeaderValueMatch: &routev3.RateLimit_Action_HeaderValueMatch{
+					DescriptorKey:   descriptorKey,
+					DescriptorValue: descriptorVal,
+					ExpectMatch: &wrapperspb.BoolValue{
+						Value: true,
+					},
+					Headers: []*routev3.HeaderMatcher{headerMatcher},
+				},
+			},
+		},
+		descriptorKey:   descriptorKey,
+		descriptorValue: descriptorVal,
+	}
+}
+
 // buildRateLimitTLSocket builds the TLS socket for the rate limit service.
 func buildRateLimitTLSocket() (*corev3.TransportSocket, error) {
 	tlsCtx := &tlsv3.UpstreamTlsContext{
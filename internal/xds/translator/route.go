@@ -113,6 +113,7 @@ func buildXdsRoute(httpRoute *ir.HTTPRoute) (*routev3.Route, error) {
 		} else {
 			return nil, err
 		}
+		router.GetRoute().HedgePolicy = buildHedgePolicy(httpRoute.Traffic.Retry)
 	}
 
 	// Add per route filter configs to the route, if needed.
@@ -463,13 +464,19 @@ func buildXdsDirectResponseAction(res *ir.CustomResponse) *routev3.DirectRespons
 	return routeAction
 }
 
-func buildXdsRequestMirrorPolicies(mirrorDestinations []*ir.RouteDestination) []*routev3.RouteAction_RequestMirrorPolicy {
+func buildXdsRequestMirrorPolicies(mirrors []*ir.MirrorPolicy) []*routev3.RouteAction_RequestMirrorPolicy {
 	var mirrorPolicies []*routev3.RouteAction_RequestMirrorPolicy
 
-	for _, mirrorDest := range mirrorDestinations {
-		mirrorPolicies = append(mirrorPolicies, &routev3.RouteAction_RequestMirrorPolicy{
-			Cluster: mirrorDest.Name,
-		})
+	for _, mirror := range mirrors {
+		mirrorPolicy := &routev3.RouteAction_RequestMirrorPolicy{
+			Cluster: mirror.Destination.Name,
+		}
+		if mirror.Percentage != nil {
+			mirrorPolicy.RuntimeFraction = &corev3.RuntimeFractionalPercent{
+				DefaultValue: translatePercentToFractionalPercent(mirror.Percentage),
+			}
+		}
+		mirrorPolicies = append(mirrorPolicies, mirrorPolicy)
 	}
 
 	return mirrorPolicies
@@ -572,6 +579,17 @@ func buildHashPolicy(httpRoute *ir.HTTPRoute) []*routev3.RouteAction_HashPolicy
 	}
 }
 
+// buildHedgePolicy returns a hedge policy that sends a hedged request when the
+// per retry timeout is reached, if hedging is enabled.
+func buildHedgePolicy(rr *ir.Retry) *routev3.HedgePolicy {
+	if rr.PerRetry == nil || !rr.PerRetry.HedgeOnTimeout || rr.PerRetry.Timeout == nil {
+		return nil
+	}
+	return &routev3.HedgePolicy{
+		HedgeOnPerTryTimeout: true,
+	}
+}
+
 func buildRetryPolicy(route *ir.HTTPRoute) (*routev3.RetryPolicy, error) {
 	rr := route.Traffic.Retry
 	rp := &routev3.RetryPolicy{
@@ -116,6 +116,10 @@ func (t *Translator) Translate(xdsIR *ir.Xds) (*types.ResourceVersionTable, erro
 		errs = errors.Join(errs, err)
 	}
 
+	if err := processLocalCluster(tCtx, xdsIR.LocalCluster); err != nil {
+		errs = errors.Join(errs, err)
+	}
+
 	// Check if an extension want to inject any clusters/secrets
 	// If no extension exists (or it doesn't subscribe to this hook) then this is a quick no-op
 	if err := processExtensionPostTranslationHook(tCtx, t.ExtensionManager); err != nil {
@@ -481,13 +485,14 @@ func (t *Translator) addRouteToRouteConfig(
 		}
 
 		if httpRoute.Mirrors != nil {
-			for _, mirrorDest := range httpRoute.Mirrors {
+			for _, mirror := range httpRoute.Mirrors {
 				if err = addXdsCluster(tCtx, &xdsClusterArgs{
-					name:         mirrorDest.Name,
-					settings:     mirrorDest.Settings,
+					name:         mirror.Destination.Name,
+					settings:     mirror.Destination.Settings,
 					tSocket:      nil,
-					endpointType: EndpointTypeStatic,
+					endpointType: buildEndpointType(mirror.Destination.Settings),
 					metrics:      metrics,
+					ipFamily:     determineIPFamily(mirror.Destination.Settings),
 				}); err != nil {
 					errs = errors.Join(errs, err)
 				}
@@ -825,7 +830,11 @@ func addXdsCluster(tCtx *types.ResourceVersionTable, args *xdsClusterArgs) error
 	}
 
 	xdsCluster := buildXdsCluster(args)
-	xdsEndpoints := buildXdsClusterLoadAssignment(args.name, args.settings)
+	var zoneAware *ir.ZoneAware
+	if args.loadBalancer != nil {
+		zoneAware = args.loadBalancer.ZoneAware
+	}
+	xdsEndpoints := buildXdsClusterLoadAssignment(args.name, args.settings, zoneAware)
 	for _, ds := range args.settings {
 		if ds.TLS != nil {
 			// Create a secret for the CA certificate only if it's not using the system trust store
@@ -851,6 +860,30 @@ func addXdsCluster(tCtx *types.ResourceVersionTable, args *xdsClusterArgs) error
 	return nil
 }
 
+// localClusterName is the name of the local cluster of the Envoy bootstrap.
+const localClusterName = "local_cluster"
+
+// processLocalCluster adds the endpoints of the Envoy proxies to the local cluster
+// of the Envoy bootstrap. The zone-aware routing requires the localities of the
+// local cluster to match the localities of the upstream clusters, so the endpoints
+// are grouped by zone without a region, like buildXdsZoneAwareClusterLoadAssignment.
+func processLocalCluster(tCtx *types.ResourceVersionTable, localCluster *ir.LocalCluster) error {
+	if localCluster == nil {
+		return nil
+	}
+
+	xdsEndpoints := &endpointv3.ClusterLoadAssignment{ClusterName: localClusterName}
+	for _, ze := range groupEndpointsByZone(localCluster.Endpoints) {
+		xdsEndpoints.Endpoints = append(xdsEndpoints.Endpoints, &endpointv3.LocalityLbEndpoints{
+			Locality: &corev3.Locality{
+				Zone: ze.zone,
+			},
+			LbEndpoints: buildXdsLbEndpoints(ze.endpoints, nil, 1),
+		})
+	}
+	return tCtx.AddXdsResource(resourcev3.EndpointType, xdsEndpoints)
+}
+
 const (
 	DefaultEndpointType EndpointType = iota
 	Static
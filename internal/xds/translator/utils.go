@@ -10,17 +10,20 @@ import (
 	"fmt"
 	"net/netip"
 	"net/url"
+	"regexp"
 	"strconv"
 	"strings"
 
 	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
 	routev3 "github.com/envoyproxy/go-control-plane/envoy/config/route/v3"
 	hcmv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/filters/network/http_connection_manager/v3"
+	"google.golang.org/protobuf/proto"
 	"google.golang.org/protobuf/types/known/anypb"
 	"k8s.io/utils/ptr"
 
 	egv1a1 "github.com/envoyproxy/gateway/api/v1alpha1"
 	"github.com/envoyproxy/gateway/internal/ir"
+	"github.com/envoyproxy/gateway/internal/utils/protocov"
 	"github.com/envoyproxy/gateway/internal/xds/types"
 )
 
@@ -115,11 +118,63 @@ func enableFilterOnRoute(route *routev3.Route, filterName string) error {
 	return nil
 }
 
+// enableFilterOnRouteWithConfig enables a filter on the provided route with the
+// provided per-route config.
+func enableFilterOnRouteWithConfig(route *routev3.Route, filterName string, config proto.Message) error {
+	if route == nil {
+		return errors.New("xds route is nil")
+	}
+
+	filterCfg := route.GetTypedPerFilterConfig()
+	if _, ok := filterCfg[filterName]; ok {
+		// This should not happen since this is the only place where the filter
+		// config is added in a route.
+		return fmt.Errorf("route already contains filter config: %s, %+v",
+			filterName, route)
+	}
+
+	configAny, err := protocov.ToAnyWithValidation(config)
+	if err != nil {
+		return err
+	}
+
+	routeCfgAny, err := anypb.New(&routev3.FilterConfig{
+		Config: configAny,
+	})
+	if err != nil {
+		return err
+	}
+
+	if filterCfg == nil {
+		route.TypedPerFilterConfig = make(map[string]*anypb.Any)
+	}
+	route.TypedPerFilterConfig[filterName] = routeCfgAny
+
+	return nil
+}
+
 // perRouteFilterName generates a unique filter name for the provided filterType and configName.
 func perRouteFilterName(filterType egv1a1.EnvoyFilter, configName string) string {
 	return fmt.Sprintf("%s/%s", filterType, configName)
 }
 
+// pathRegex returns a regex that matches the ":path" pseudo-header of the
+// requests whose path, excluding the query string, matches the provided path match.
+func pathRegex(match *ir.StringMatch) string {
+	const query = `(\?.*)?`
+	switch {
+	case match.Exact != nil:
+		return regexp.QuoteMeta(*match.Exact) + query
+	case match.Prefix != nil:
+		return regexp.QuoteMeta(*match.Prefix) + `[^?]*` + query
+	case match.Suffix != nil:
+		return `[^?]*` + regexp.QuoteMeta(*match.Suffix) + query
+	case match.SafeRegex != nil:
+		return `(?:` + *match.SafeRegex + `)` + query
+	}
+	return `[^?]*` + query
+}
+
 func hcmContainsFilter(mgr *hcmv3.HttpConnectionManager, filterName string) bool {
 	for _, existingFilter := range mgr.HttpFilters {
 		if existingFilter.Name == filterName {
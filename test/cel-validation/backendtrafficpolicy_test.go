@@ -477,6 +477,93 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 				"spec.loadBalancer: Invalid value: \"object\": Currently SlowStart is only supported for RoundRobin and LeastRequest load balancers.",
 			},
 		},
+		{
+			desc: "roundrobin with zoneAware preferLocal",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						LoadBalancer: &egv1a1.LoadBalancer{
+							Type: egv1a1.RoundRobinLoadBalancerType,
+							ZoneAware: &egv1a1.ZoneAware{
+								PreferLocal: &egv1a1.PreferLocalZone{
+									MinEndpointsThreshold: ptr.To[uint64](3),
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "consistenthash with zoneAware preferLocal",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						LoadBalancer: &egv1a1.LoadBalancer{
+							Type: egv1a1.ConsistentHashLoadBalancerType,
+							ConsistentHash: &egv1a1.ConsistentHash{
+								Type: "SourceIP",
+							},
+							ZoneAware: &egv1a1.ZoneAware{
+								PreferLocal: &egv1a1.PreferLocalZone{},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.loadBalancer: Invalid value: \"object\": PreferLocal zone-aware routing is not supported for ConsistentHash load balancers.",
+			},
+		},
+		{
+			desc: "zoneAware with both preferLocal and weightedZones",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						LoadBalancer: &egv1a1.LoadBalancer{
+							Type: egv1a1.LeastRequestLoadBalancerType,
+							ZoneAware: &egv1a1.ZoneAware{
+								PreferLocal: &egv1a1.PreferLocalZone{},
+								WeightedZones: []egv1a1.WeightedZone{
+									{Zone: "zone-a", Weight: 1},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.loadBalancer.zoneAware: Invalid value: \"object\": exactly one of preferLocal or weightedZones must be specified",
+			},
+		},
 		{
 			desc: "Using both httpStatus and grpcStatus in abort fault injection",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
@@ -1144,7 +1231,7 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 			},
 		},
 		{
-			desc: "valid connectionBufferLimitBytes format",
+			desc: "valid rate limit selectors",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1156,16 +1243,56 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 							},
 						},
 					},
-					ClusterSettings: egv1a1.ClusterSettings{
-						Connection: &egv1a1.BackendConnection{
-							BufferLimit: ptr.To(resource.MustParse("1Mi")),
+					RateLimit: &egv1a1.RateLimitSpec{
+						Type: egv1a1.GlobalRateLimitType,
+						Global: &egv1a1.GlobalRateLimit{
+							Rules: []egv1a1.RateLimitRule{
+								{
+									ClientSelectors: []egv1a1.RateLimitSelectCondition{
+										{
+											Methods: []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodGet, gwapiv1.HTTPMethodPost},
+											Path: &egv1a1.StringMatch{
+												Type:  ptr.To(egv1a1.StringMatchPrefix),
+												Value: "/api",
+											},
+											QueryParams: []egv1a1.QueryParamMatch{
+												{
+													Name:  "tier",
+													Value: ptr.To("free"),
+												},
+												{
+													Type: ptr.To(egv1a1.QueryParamMatchDistinct),
+													Name: "tenant",
+												},
+											},
+											JWTClaims: []egv1a1.JWTClaimMatch{
+												{
+													Provider: "example",
+													Name:     "org.plan",
+													Value:    ptr.To("gold"),
+												},
+												{
+													Type:     ptr.To(egv1a1.JWTClaimMatchDistinct),
+													Provider: "example",
+													Name:     "sub",
+												},
+											},
+										},
+									},
+									Limit: egv1a1.RateLimitValue{
+										Requests: 10,
+										Unit:     "Minute",
+									},
+								},
+							},
 						},
 					},
 				}
 			},
+			wantErrors: []string{},
 		},
 		{
-			desc: "connectionBufferLimitBytes given as a number",
+			desc: "rate limit query param without value",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1177,17 +1304,37 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 							},
 						},
 					},
-					ClusterSettings: egv1a1.ClusterSettings{
-						Connection: &egv1a1.BackendConnection{
-							BufferLimit: ptr.To(resource.MustParse("12345678")),
+					RateLimit: &egv1a1.RateLimitSpec{
+						Type: egv1a1.GlobalRateLimitType,
+						Global: &egv1a1.GlobalRateLimit{
+							Rules: []egv1a1.RateLimitRule{
+								{
+									ClientSelectors: []egv1a1.RateLimitSelectCondition{
+										{
+											QueryParams: []egv1a1.QueryParamMatch{
+												{
+													Type: ptr.To(egv1a1.QueryParamMatchExact),
+													Name: "tier",
+												},
+											},
+										},
+									},
+									Limit: egv1a1.RateLimitValue{
+										Requests: 10,
+										Unit:     "Minute",
+									},
+								},
+							},
 						},
 					},
 				}
 			},
-			wantErrors: []string{},
+			wantErrors: []string{
+				"spec.rateLimit.global.rules[0].clientSelectors[0].queryParams[0]: Invalid value: \"object\": value must be set for types other than Distinct, and must not be set for Distinct",
+			},
 		},
 		{
-			desc: "invalid connectionBufferLimitBytes format",
+			desc: "rate limit distinct query param with value",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1199,19 +1346,38 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 							},
 						},
 					},
-					ClusterSettings: egv1a1.ClusterSettings{
-						Connection: &egv1a1.BackendConnection{
-							BufferLimit: ptr.To(resource.MustParse("1m")),
+					RateLimit: &egv1a1.RateLimitSpec{
+						Type: egv1a1.GlobalRateLimitType,
+						Global: &egv1a1.GlobalRateLimit{
+							Rules: []egv1a1.RateLimitRule{
+								{
+									ClientSelectors: []egv1a1.RateLimitSelectCondition{
+										{
+											QueryParams: []egv1a1.QueryParamMatch{
+												{
+													Type:  ptr.To(egv1a1.QueryParamMatchDistinct),
+													Name:  "tenant",
+													Value: ptr.To("foo"),
+												},
+											},
+										},
+									},
+									Limit: egv1a1.RateLimitValue{
+										Requests: 10,
+										Unit:     "Minute",
+									},
+								},
+							},
 						},
 					},
 				}
 			},
 			wantErrors: []string{
-				"spec.connection.bufferLimit: Invalid value: \"1m\": spec.connection.bufferLimit in body should match '^[1-9]+[0-9]*([EPTGMK]i|[EPTGMk])?$', <nil>: Invalid value: \"\"",
+				"spec.rateLimit.global.rules[0].clientSelectors[0].queryParams[0]: Invalid value: \"object\": value must be set for types other than Distinct, and must not be set for Distinct",
 			},
 		},
 		{
-			desc: "both targetref and targetrefs specified",
+			desc: "rate limit distinct jwt claim with value",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1222,12 +1388,28 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 								Name:  gwapiv1a2.ObjectName("eg"),
 							},
 						},
-						TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
-							{
-								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
-									Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
-									Kind:  gwapiv1a2.Kind("Gateway"),
-									Name:  gwapiv1a2.ObjectName("eg"),
+					},
+					RateLimit: &egv1a1.RateLimitSpec{
+						Type: egv1a1.GlobalRateLimitType,
+						Global: &egv1a1.GlobalRateLimit{
+							Rules: []egv1a1.RateLimitRule{
+								{
+									ClientSelectors: []egv1a1.RateLimitSelectCondition{
+										{
+											JWTClaims: []egv1a1.JWTClaimMatch{
+												{
+													Type:     ptr.To(egv1a1.JWTClaimMatchDistinct),
+													Provider: "example",
+													Name:     "sub",
+													Value:    ptr.To("foo"),
+												},
+											},
+										},
+									},
+									Limit: egv1a1.RateLimitValue{
+										Requests: 10,
+										Unit:     "Minute",
+									},
 								},
 							},
 						},
@@ -1235,20 +1417,44 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 				}
 			},
 			wantErrors: []string{
-				" Invalid value: \"object\": either targetRef or targetRefs must be used",
+				"spec.rateLimit.global.rules[0].clientSelectors[0].jwtClaims[0]: Invalid value: \"object\": value must be set for type Exact, and only for type Exact",
 			},
 		},
 		{
-			desc: "target selectors without targetRefs or targetRef",
-			mutate: func(sp *egv1a1.BackendTrafficPolicy) {
-				sp.Spec = egv1a1.BackendTrafficPolicySpec{
+			desc: "valid rate limit cost",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
-						TargetSelectors: []egv1a1.TargetSelector{
-							{
-								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
-								Kind:  "HTTPRoute",
-								MatchLabels: map[string]string{
-									"eg/namespace": "reference-apps",
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					RateLimit: &egv1a1.RateLimitSpec{
+						Type: egv1a1.GlobalRateLimitType,
+						Global: &egv1a1.GlobalRateLimit{
+							Rules: []egv1a1.RateLimitRule{
+								{
+									Limit: egv1a1.RateLimitValue{
+										Requests: 10,
+										Unit:     "Minute",
+									},
+									Cost: &egv1a1.RateLimitCost{
+										Request: &egv1a1.RateLimitCostSpecifier{
+											From:   egv1a1.RateLimitCostFromNumber,
+											Number: ptr.To[uint64](0),
+										},
+										Response: &egv1a1.RateLimitCostSpecifier{
+											From: egv1a1.RateLimitCostFromMetadata,
+											Metadata: &egv1a1.RateLimitCostMetadata{
+												Namespace: "io.envoy.ai_gateway",
+												Key:       "llm_total_token",
+											},
+										},
+									},
 								},
 							},
 						},
@@ -1258,7 +1464,7 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 			wantErrors: []string{},
 		},
 		{
-			desc: "both targetref and targetrefs specified",
+			desc: "rate limit cost without request or response",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1270,17 +1476,16 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 							},
 						},
 					},
-					ResponseOverride: []*egv1a1.ResponseOverride{
-						{
-							Match: egv1a1.CustomResponseMatch{
-								StatusCodes: []egv1a1.StatusCodeMatch{
-									{
-										Type: ptr.To(egv1a1.StatusCodeValueTypeValue),
-										Range: &egv1a1.StatusCodeRange{
-											Start: 100,
-											End:   200,
-										},
+					RateLimit: &egv1a1.RateLimitSpec{
+						Type: egv1a1.GlobalRateLimitType,
+						Global: &egv1a1.GlobalRateLimit{
+							Rules: []egv1a1.RateLimitRule{
+								{
+									Limit: egv1a1.RateLimitValue{
+										Requests: 10,
+										Unit:     "Minute",
 									},
+									Cost: &egv1a1.RateLimitCost{},
 								},
 							},
 						},
@@ -1288,11 +1493,11 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 				}
 			},
 			wantErrors: []string{
-				"value must be set for type Value",
+				"spec.rateLimit.global.rules[0].cost: Invalid value: \"object\": at least one of request or response must be specified",
 			},
 		},
 		{
-			desc: "both targetref and targetrefs specified",
+			desc: "rate limit cost with mismatched from",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1304,14 +1509,19 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 							},
 						},
 					},
-					ResponseOverride: []*egv1a1.ResponseOverride{
-						{
-							Match: egv1a1.CustomResponseMatch{
-								StatusCodes: []egv1a1.StatusCodeMatch{
-									{
-										Range: &egv1a1.StatusCodeRange{
-											Start: 100,
-											End:   200,
+					RateLimit: &egv1a1.RateLimitSpec{
+						Type: egv1a1.GlobalRateLimitType,
+						Global: &egv1a1.GlobalRateLimit{
+							Rules: []egv1a1.RateLimitRule{
+								{
+									Limit: egv1a1.RateLimitValue{
+										Requests: 10,
+										Unit:     "Minute",
+									},
+									Cost: &egv1a1.RateLimitCost{
+										Request: &egv1a1.RateLimitCostSpecifier{
+											From:   egv1a1.RateLimitCostFromHeader,
+											Number: ptr.To[uint64](2),
 										},
 									},
 								},
@@ -1321,11 +1531,12 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 				}
 			},
 			wantErrors: []string{
-				"value must be set for type Value",
+				"spec.rateLimit.global.rules[0].cost.request: Invalid value: \"object\": number must be set for from Number, and only for from Number",
+				"spec.rateLimit.global.rules[0].cost.request: Invalid value: \"object\": header must be set for from Header, and only for from Header",
 			},
 		},
 		{
-			desc: "both targetref and targetrefs specified",
+			desc: "valid connectionBufferLimitBytes format",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1337,26 +1548,16 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 							},
 						},
 					},
-					ResponseOverride: []*egv1a1.ResponseOverride{
-						{
-							Match: egv1a1.CustomResponseMatch{
-								StatusCodes: []egv1a1.StatusCodeMatch{
-									{
-										Type:  ptr.To(egv1a1.StatusCodeValueTypeRange),
-										Value: ptr.To(100),
-									},
-								},
-							},
+					ClusterSettings: egv1a1.ClusterSettings{
+						Connection: &egv1a1.BackendConnection{
+							BufferLimit: ptr.To(resource.MustParse("1Mi")),
 						},
 					},
 				}
 			},
-			wantErrors: []string{
-				"range must be set for type Range",
-			},
 		},
 		{
-			desc: "both targetref and targetrefs specified",
+			desc: "connectionBufferLimitBytes given as a number",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1368,29 +1569,17 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 							},
 						},
 					},
-					ResponseOverride: []*egv1a1.ResponseOverride{
-						{
-							Match: egv1a1.CustomResponseMatch{
-								StatusCodes: []egv1a1.StatusCodeMatch{
-									{
-										Type: ptr.To(egv1a1.StatusCodeValueTypeRange),
-										Range: &egv1a1.StatusCodeRange{
-											Start: 200,
-											End:   100,
-										},
-									},
-								},
-							},
+					ClusterSettings: egv1a1.ClusterSettings{
+						Connection: &egv1a1.BackendConnection{
+							BufferLimit: ptr.To(resource.MustParse("12345678")),
 						},
 					},
 				}
 			},
-			wantErrors: []string{
-				"end must be greater than start",
-			},
+			wantErrors: []string{},
 		},
 		{
-			desc: "both targetref and targetrefs specified",
+			desc: "invalid connectionBufferLimitBytes format",
 			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
 				btp.Spec = egv1a1.BackendTrafficPolicySpec{
 					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
@@ -1402,29 +1591,15 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 							},
 						},
 					},
-					ResponseOverride: []*egv1a1.ResponseOverride{
-						{
-							Match: egv1a1.CustomResponseMatch{
-								StatusCodes: []egv1a1.StatusCodeMatch{
-									{
-										Value: ptr.To(100),
-									},
-								},
-							},
-							Response: egv1a1.CustomResponse{
-								Body: egv1a1.CustomResponseBody{
-									ValueRef: &gwapiv1a2.LocalObjectReference{
-										Kind: gwapiv1a2.Kind("ConfigMap"),
-										Name: gwapiv1a2.ObjectName("eg"),
-									},
-								},
-							},
+					ClusterSettings: egv1a1.ClusterSettings{
+						Connection: &egv1a1.BackendConnection{
+							BufferLimit: ptr.To(resource.MustParse("1m")),
 						},
 					},
 				}
 			},
 			wantErrors: []string{
-				"inline must be set for type Inline",
+				"spec.connection.bufferLimit: Invalid value: \"1m\": spec.connection.bufferLimit in body should match '^[1-9]+[0-9]*([EPTGMK]i|[EPTGMk])?$', <nil>: Invalid value: \"\"",
 			},
 		},
 		{
@@ -1439,14 +1614,231 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 								Name:  gwapiv1a2.ObjectName("eg"),
 							},
 						},
-					},
-					ResponseOverride: []*egv1a1.ResponseOverride{
-						{
-							Match: egv1a1.CustomResponseMatch{
-								StatusCodes: []egv1a1.StatusCodeMatch{
-									{
-										Value: ptr.To(100),
-									},
+						TargetRefs: []gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							{
+								LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+									Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+									Kind:  gwapiv1a2.Kind("Gateway"),
+									Name:  gwapiv1a2.ObjectName("eg"),
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				" Invalid value: \"object\": either targetRef or targetRefs must be used",
+			},
+		},
+		{
+			desc: "target selectors without targetRefs or targetRef",
+			mutate: func(sp *egv1a1.BackendTrafficPolicy) {
+				sp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "both targetref and targetrefs specified",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ResponseOverride: []*egv1a1.ResponseOverride{
+						{
+							Match: egv1a1.CustomResponseMatch{
+								StatusCodes: []egv1a1.StatusCodeMatch{
+									{
+										Type: ptr.To(egv1a1.StatusCodeValueTypeValue),
+										Range: &egv1a1.StatusCodeRange{
+											Start: 100,
+											End:   200,
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"value must be set for type Value",
+			},
+		},
+		{
+			desc: "both targetref and targetrefs specified",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ResponseOverride: []*egv1a1.ResponseOverride{
+						{
+							Match: egv1a1.CustomResponseMatch{
+								StatusCodes: []egv1a1.StatusCodeMatch{
+									{
+										Range: &egv1a1.StatusCodeRange{
+											Start: 100,
+											End:   200,
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"value must be set for type Value",
+			},
+		},
+		{
+			desc: "both targetref and targetrefs specified",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ResponseOverride: []*egv1a1.ResponseOverride{
+						{
+							Match: egv1a1.CustomResponseMatch{
+								StatusCodes: []egv1a1.StatusCodeMatch{
+									{
+										Type:  ptr.To(egv1a1.StatusCodeValueTypeRange),
+										Value: ptr.To(100),
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"range must be set for type Range",
+			},
+		},
+		{
+			desc: "both targetref and targetrefs specified",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ResponseOverride: []*egv1a1.ResponseOverride{
+						{
+							Match: egv1a1.CustomResponseMatch{
+								StatusCodes: []egv1a1.StatusCodeMatch{
+									{
+										Type: ptr.To(egv1a1.StatusCodeValueTypeRange),
+										Range: &egv1a1.StatusCodeRange{
+											Start: 200,
+											End:   100,
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"end must be greater than start",
+			},
+		},
+		{
+			desc: "both targetref and targetrefs specified",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ResponseOverride: []*egv1a1.ResponseOverride{
+						{
+							Match: egv1a1.CustomResponseMatch{
+								StatusCodes: []egv1a1.StatusCodeMatch{
+									{
+										Value: ptr.To(100),
+									},
+								},
+							},
+							Response: egv1a1.CustomResponse{
+								Body: egv1a1.CustomResponseBody{
+									ValueRef: &gwapiv1a2.LocalObjectReference{
+										Kind: gwapiv1a2.Kind("ConfigMap"),
+										Name: gwapiv1a2.ObjectName("eg"),
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"inline must be set for type Inline",
+			},
+		},
+		{
+			desc: "both targetref and targetrefs specified",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ResponseOverride: []*egv1a1.ResponseOverride{
+						{
+							Match: egv1a1.CustomResponseMatch{
+								StatusCodes: []egv1a1.StatusCodeMatch{
+									{
+										Value: ptr.To(100),
+									},
 								},
 							},
 							Response: egv1a1.CustomResponse{
@@ -1502,6 +1894,570 @@ func TestBackendTrafficPolicyTarget(t *testing.T) {
 				"only ConfigMap is supported for ValueRe",
 			},
 		},
+		{
+			desc: "oauth2ClientCredentials with backendRefs",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					OAuth2ClientCredentials: &egv1a1.OAuth2ClientCredentials{
+						BackendCluster: egv1a1.BackendCluster{
+							BackendRefs: []egv1a1.BackendRef{
+								{
+									BackendObjectReference: gwapiv1.BackendObjectReference{
+										Name: "oauth2-service",
+										Port: ptr.To(gwapiv1.PortNumber(80)),
+									},
+								},
+							},
+						},
+						TokenEndpoint: "http://oauth2-service/token",
+						ClientID:      "client-id",
+						ClientSecret: gwapiv1.SecretObjectReference{
+							Name: "client-secret",
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "oauth2ClientCredentials with backendRef",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					OAuth2ClientCredentials: &egv1a1.OAuth2ClientCredentials{
+						BackendCluster: egv1a1.BackendCluster{
+							BackendRef: &gwapiv1.BackendObjectReference{
+								Name: "oauth2-service",
+								Port: ptr.To(gwapiv1.PortNumber(80)),
+							},
+						},
+						TokenEndpoint: "http://oauth2-service/token",
+						ClientID:      "client-id",
+						ClientSecret: gwapiv1.SecretObjectReference{
+							Name: "client-secret",
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"BackendRefs must be used, backendRef is not supported.",
+			},
+		},
+		{
+			desc: "adaptive concurrency with minRTT interval",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					AdaptiveConcurrency: &egv1a1.AdaptiveConcurrency{
+						MinRTT: &egv1a1.AdaptiveConcurrencyMinRTT{
+							Interval:       ptr.To(gwapiv1.Duration("30s")),
+							RequestCount:   ptr.To[uint32](20),
+							MinConcurrency: ptr.To[uint32](5),
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "adaptive concurrency with both minRTT interval and fixedValue",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					AdaptiveConcurrency: &egv1a1.AdaptiveConcurrency{
+						MinRTT: &egv1a1.AdaptiveConcurrencyMinRTT{
+							Interval:   ptr.To(gwapiv1.Duration("30s")),
+							FixedValue: ptr.To(gwapiv1.Duration("50ms")),
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.adaptiveConcurrency.minRTT: Invalid value: \"object\": only one of interval or fixedValue can be specified",
+			},
+		},
+		{
+			desc: "adaptive concurrency with sampling settings for fixed minRTT",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					AdaptiveConcurrency: &egv1a1.AdaptiveConcurrency{
+						MinRTT: &egv1a1.AdaptiveConcurrencyMinRTT{
+							FixedValue:   ptr.To(gwapiv1.Duration("50ms")),
+							RequestCount: ptr.To[uint32](20),
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.adaptiveConcurrency.minRTT: Invalid value: \"object\": requestCount, jitter and minConcurrency can only be specified when the minRTT is sampled",
+			},
+		},
+		{
+			desc: "retry with budget and hedging",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						Retry: &egv1a1.Retry{
+							PerRetry: &egv1a1.PerRetryPolicy{
+								Timeout:        &metav1.Duration{Duration: time.Millisecond * 100},
+								HedgeOnTimeout: ptr.To(true),
+							},
+							Budget: &egv1a1.RetryBudget{
+								Percent:        ptr.To[uint32](25),
+								MinConcurrency: ptr.To[uint32](5),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "retry with hedging and no per retry timeout",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					ClusterSettings: egv1a1.ClusterSettings{
+						Retry: &egv1a1.Retry{
+							PerRetry: &egv1a1.PerRetryPolicy{
+								HedgeOnTimeout: ptr.To(true),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.retry.perRetry: Invalid value: \"object\": timeout must be set when hedgeOnTimeout is enabled",
+			},
+		},
+		{
+			desc: "admission control with http success criteria",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					AdmissionControl: &egv1a1.AdmissionControl{
+						SuccessCriteria: &egv1a1.AdmissionControlSuccessCriteria{
+							HTTP: &egv1a1.AdmissionControlHTTPSuccessCriteria{
+								StatusRanges: []egv1a1.StatusCodeRange{
+									{Start: 200, End: 299},
+								},
+							},
+						},
+						SuccessRateThreshold: ptr.To[uint32](90),
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "admission control with empty success criteria",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					AdmissionControl: &egv1a1.AdmissionControl{
+						SuccessCriteria: &egv1a1.AdmissionControlSuccessCriteria{},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.admissionControl.successCriteria: Invalid value: \"object\": at least one of http or grpc must be specified",
+			},
+		},
+		{
+			desc: "admission control with invalid http status range",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					AdmissionControl: &egv1a1.AdmissionControl{
+						SuccessCriteria: &egv1a1.AdmissionControlSuccessCriteria{
+							HTTP: &egv1a1.AdmissionControlHTTPSuccessCriteria{
+								StatusRanges: []egv1a1.StatusCodeRange{
+									{Start: 299, End: 200},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.admissionControl.successCriteria.http.statusRanges[0]: Invalid value: \"object\": end must be greater than start",
+			},
+		},
+		{
+			desc: "multiple compressors",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					Compression: []*egv1a1.Compression{
+						{
+							Type: egv1a1.BrotliCompressorType,
+							Brotli: &egv1a1.BrotliCompressor{
+								Quality:     ptr.To[uint32](5),
+								EncoderMode: ptr.To(egv1a1.BrotliEncoderModeText),
+							},
+						},
+						{
+							Type: egv1a1.ZstdCompressorType,
+							Zstd: &egv1a1.ZstdCompressor{
+								CompressionLevel: ptr.To[uint32](3),
+							},
+						},
+						{
+							Type: egv1a1.GzipCompressorType,
+						},
+					},
+					CompressionSettings: &egv1a1.CompressionSettings{
+						MinContentLength: ptr.To[uint32](100),
+						ContentTypes:     []string{"text/html"},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "compression settings without compression",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					CompressionSettings: &egv1a1.CompressionSettings{
+						MinContentLength: ptr.To[uint32](100),
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec: Invalid value: \"object\": compressionSettings requires compression",
+			},
+		},
+		{
+			desc: "compressor config mismatches the type",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					Compression: []*egv1a1.Compression{
+						{
+							Type: egv1a1.GzipCompressorType,
+							Brotli: &egv1a1.BrotliCompressor{
+								Quality: ptr.To[uint32](5),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.compression[0]: Invalid value: \"object\": only gzip can be specified for the Gzip compressor",
+			},
+		},
+		{
+			desc: "invalid brotli quality",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					Compression: []*egv1a1.Compression{
+						{
+							Type: egv1a1.BrotliCompressorType,
+							Brotli: &egv1a1.BrotliCompressor{
+								Quality: ptr.To[uint32](12),
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.compression[0].brotli.quality: Invalid value: 12: spec.compression[0].brotli.quality in body should be less than or equal to 11",
+			},
+		},
+		{
+			desc: "valid cache",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					Cache: &egv1a1.Cache{
+						MaxBodySize: ptr.To(resource.MustParse("1Mi")),
+						Methods:     []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodGet, gwapiv1.HTTPMethodHead},
+						StatusCodes: []egv1a1.HTTPStatus{200, 404},
+						VaryHeaders: []string{"Accept-Encoding"},
+						TTL:         ptr.To(gwapiv1.Duration("5m")),
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "invalid cache method",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					Cache: &egv1a1.Cache{
+						Methods: []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodPost},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.cache.methods[0]: Unsupported value: \"POST\": supported values: \"GET\", \"HEAD\"",
+			},
+		},
+		{
+			desc: "invalid cache status code",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					Cache: &egv1a1.Cache{
+						StatusCodes: []egv1a1.HTTPStatus{600},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.cache.statusCodes[0]: Invalid value: 600: spec.cache.statusCodes[0] in body should be less than or equal to 599",
+			},
+		},
+		{
+			desc: "valid request buffer",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					RequestBuffer: &egv1a1.RequestBuffer{
+						Limit: resource.MustParse("10Mi"),
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "invalid request buffer limit",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					RequestBuffer: &egv1a1.RequestBuffer{
+						Limit: resource.MustParse("15m"),
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.requestBuffer.limit: Invalid value: \"15m\": spec.requestBuffer.limit in body should match '^[1-9]+[0-9]*([EPTGMK]i|[EPTGMk])?$'",
+			},
+		},
+		{
+			desc: "valid bandwidth limit",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					BandwidthLimit: &egv1a1.BandwidthLimit{
+						Direction:    ptr.To(egv1a1.BandwidthLimitDirectionRequestAndResponse),
+						LimitKbps:    1024,
+						FillInterval: ptr.To(gwapiv1.Duration("100ms")),
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "invalid bandwidth limit fill interval",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					BandwidthLimit: &egv1a1.BandwidthLimit{
+						LimitKbps:    1024,
+						FillInterval: ptr.To(gwapiv1.Duration("10ms")),
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.bandwidthLimit.fillInterval: Invalid value: \"string\": fillInterval must be between 20ms and 1s",
+			},
+		},
+		{
+			desc: "invalid bandwidth limit",
+			mutate: func(btp *egv1a1.BackendTrafficPolicy) {
+				btp.Spec = egv1a1.BackendTrafficPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: gwapiv1a2.Group("gateway.networking.k8s.io"),
+								Kind:  gwapiv1a2.Kind("Gateway"),
+								Name:  gwapiv1a2.ObjectName("eg"),
+							},
+						},
+					},
+					BandwidthLimit: &egv1a1.BandwidthLimit{
+						LimitKbps: 0,
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.bandwidthLimit.limitKbps: Invalid value: 0: spec.bandwidthLimit.limitKbps in body should be greater than or equal to 1",
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -584,6 +584,178 @@ func TestEnvoyExtensionPolicyTarget(t *testing.T) {
 				"spec.extProc[0].processingMode.response.attributes[2]: Invalid value: \"plugin_name\": spec.extProc[0].processingMode.response.attributes[2] in body should match '^(connection\\.|source\\.|destination\\.|request\\.|response\\.|upstream\\.|xds\\.route_)[a-z_1-9]*$'",
 			},
 		},
+		{
+			desc: "Lua with inline and valueRef",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					Lua: []egv1a1.Lua{
+						{
+							Type:   egv1a1.LuaValueTypeInline,
+							Inline: ptr.To("function envoy_on_request(request_handle) end"),
+						},
+						{
+							Type: egv1a1.LuaValueTypeValueRef,
+							ValueRef: &gwapiv1.LocalObjectReference{
+								Kind: "ConfigMap",
+								Name: "lua-script",
+							},
+							FailOpen: ptr.To(true),
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "Lua with missing inline",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					Lua: []egv1a1.Lua{
+						{
+							Type: egv1a1.LuaValueTypeInline,
+							ValueRef: &gwapiv1.LocalObjectReference{
+								Kind: "ConfigMap",
+								Name: "lua-script",
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.lua[0]: Invalid value: \"object\": inline must be set for type Inline",
+			},
+		},
+		{
+			desc: "Lua with valueRef to unsupported kind",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					Lua: []egv1a1.Lua{
+						{
+							Type: egv1a1.LuaValueTypeValueRef,
+							ValueRef: &gwapiv1.LocalObjectReference{
+								Kind: "Secret",
+								Name: "lua-script",
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.lua[0]: Invalid value: \"object\": only ConfigMap is supported for ValueRef",
+			},
+		},
+		{
+			desc: "valid gRPC-JSON transcoder",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+						ProtoDescriptorSet: egv1a1.ProtoDescriptorSet{
+							ValueRef: gwapiv1.LocalObjectReference{
+								Kind: "ConfigMap",
+								Name: "greeter-descriptor",
+							},
+							Key: "descriptor.pb",
+						},
+						Services: []string{"helloworld.Greeter"},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "HTTPRoute",
+								Name:  "httpbin-route",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "gRPC-JSON transcoder with valueRef to unsupported kind",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+						ProtoDescriptorSet: egv1a1.ProtoDescriptorSet{
+							ValueRef: gwapiv1.LocalObjectReference{
+								Kind: "Service",
+								Name: "greeter-descriptor",
+							},
+							Key: "descriptor.pb",
+						},
+						Services: []string{"helloworld.Greeter"},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "HTTPRoute",
+								Name:  "httpbin-route",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.grpcJSONTranscoder.protoDescriptorSet: Invalid value: \"object\": only ConfigMap and Secret are supported for valueRef",
+			},
+		},
+		{
+			desc: "gRPC-JSON transcoder without services",
+			mutate: func(sp *egv1a1.EnvoyExtensionPolicy) {
+				sp.Spec = egv1a1.EnvoyExtensionPolicySpec{
+					GRPCJSONTranscoder: &egv1a1.GRPCJSONTranscoder{
+						ProtoDescriptorSet: egv1a1.ProtoDescriptorSet{
+							ValueRef: gwapiv1.LocalObjectReference{
+								Kind: "Secret",
+								Name: "greeter-descriptor",
+							},
+							Key: "descriptor.pb",
+						},
+						Services: []string{},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "HTTPRoute",
+								Name:  "httpbin-route",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"spec.grpcJSONTranscoder.services: Invalid value: 0: spec.grpcJSONTranscoder.services in body should have at least 1 items",
+			},
+		},
 	}
 
 	for _, tc := range cases {
@@ -586,6 +586,75 @@ func TestSecurityPolicyTarget(t *testing.T) {
 			},
 			wantErrors: []string{" backendRef or backendRefs needs to be set"},
 		},
+		{
+			desc: "extAuth with decision cache",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					ExtAuth: &egv1a1.ExtAuth{
+						GRPC: &egv1a1.GRPCExtAuthService{
+							BackendCluster: egv1a1.BackendCluster{
+								BackendRef: &gwapiv1.BackendObjectReference{
+									Name: "grpc-auth-service",
+									Port: ptr.To(gwapiv1.PortNumber(15001)),
+								},
+							},
+						},
+						ContextExtensions: []egv1a1.ExtAuthContextExtension{
+							{
+								Name:  "service",
+								Value: "billing",
+							},
+						},
+						DecisionCache: &egv1a1.ExtAuthDecisionCache{
+							Headers:    []string{"authorization"},
+							TTL:        ptr.To(gwapiv1.Duration("30s")),
+							MaxEntries: ptr.To[uint32](500),
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "extAuth decision cache with ttl less than 1s",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					ExtAuth: &egv1a1.ExtAuth{
+						GRPC: &egv1a1.GRPCExtAuthService{
+							BackendCluster: egv1a1.BackendCluster{
+								BackendRef: &gwapiv1.BackendObjectReference{
+									Name: "grpc-auth-service",
+									Port: ptr.To(gwapiv1.PortNumber(15001)),
+								},
+							},
+						},
+						DecisionCache: &egv1a1.ExtAuthDecisionCache{
+							Headers: []string{"authorization"},
+							TTL:     ptr.To(gwapiv1.Duration("500ms")),
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"ttl must be at least 1s"},
+		},
 		{
 			desc: "no extAuth",
 			mutate: func(sp *egv1a1.SecurityPolicy) {
@@ -787,7 +856,7 @@ func TestSecurityPolicyTarget(t *testing.T) {
 						Providers: []egv1a1.JWTProvider{
 							{
 								Name: "example",
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://example.com/jwt/jwks.json",
 								},
 							},
@@ -814,7 +883,7 @@ func TestSecurityPolicyTarget(t *testing.T) {
 						Providers: []egv1a1.JWTProvider{
 							{
 								Name: "example",
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://example.com/jwt/jwks.json",
 								},
 								ClaimToHeaders: []egv1a1.ClaimToHeader{
@@ -847,7 +916,7 @@ func TestSecurityPolicyTarget(t *testing.T) {
 						Providers: []egv1a1.JWTProvider{
 							{
 								Name: "example",
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://example.com/jwt/jwks.json",
 								},
 								RecomputeRoute: ptr.To(true),
@@ -875,7 +944,7 @@ func TestSecurityPolicyTarget(t *testing.T) {
 						Providers: []egv1a1.JWTProvider{
 							{
 								Name: "example",
-								RemoteJWKS: egv1a1.RemoteJWKS{
+								RemoteJWKS: &egv1a1.RemoteJWKS{
 									URI: "https://example.com/jwt/jwks.json",
 								},
 								ClaimToHeaders: []egv1a1.ClaimToHeader{
@@ -901,6 +970,178 @@ func TestSecurityPolicyTarget(t *testing.T) {
 			},
 			wantErrors: []string{},
 		},
+		{
+			desc: "jwt with local jwks",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name: "example",
+								LocalJWKS: &egv1a1.LocalJWKS{
+									Type:   ptr.To(egv1a1.LocalJWKSTypeInline),
+									Inline: ptr.To("{\"keys\":[]}"),
+								},
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "jwt with local jwks from configmap",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name: "example",
+								LocalJWKS: &egv1a1.LocalJWKS{
+									Type: ptr.To(egv1a1.LocalJWKSTypeValueRef),
+									ValueRef: &gwapiv1.LocalObjectReference{
+										Kind: "ConfigMap",
+										Name: "jwks",
+									},
+								},
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "jwt with both remote and local jwks",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name: "example",
+								RemoteJWKS: &egv1a1.RemoteJWKS{
+									URI: "https://example.com/jwt/jwks.json",
+								},
+								LocalJWKS: &egv1a1.LocalJWKS{
+									Type:   ptr.To(egv1a1.LocalJWKSTypeInline),
+									Inline: ptr.To("{\"keys\":[]}"),
+								},
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"exactly one of remoteJWKS or localJWKS must be specified"},
+		},
+		{
+			desc: "jwt without jwks",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name: "example",
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"exactly one of remoteJWKS or localJWKS must be specified"},
+		},
+		{
+			desc: "jwt with local jwks missing valueRef",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name: "example",
+								LocalJWKS: &egv1a1.LocalJWKS{
+									Type: ptr.To(egv1a1.LocalJWKSTypeValueRef),
+								},
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"valueRef must be set for type ValueRef"},
+		},
+		{
+			desc: "jwt with local jwks from unsupported kind",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					JWT: &egv1a1.JWT{
+						Providers: []egv1a1.JWTProvider{
+							{
+								Name: "example",
+								LocalJWKS: &egv1a1.LocalJWKS{
+									Type: ptr.To(egv1a1.LocalJWKSTypeValueRef),
+									ValueRef: &gwapiv1.LocalObjectReference{
+										Kind: "Service",
+										Name: "jwks",
+									},
+								},
+							},
+						},
+					},
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetRef: &gwapiv1a2.LocalPolicyTargetReferenceWithSectionName{
+							LocalPolicyTargetReference: gwapiv1a2.LocalPolicyTargetReference{
+								Group: "gateway.networking.k8s.io",
+								Kind:  "Gateway",
+								Name:  "eg",
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"only ConfigMap and Secret are supported for ValueRef"},
+		},
 		{
 			desc: "target selectors without targetRefs or targetRef",
 			mutate: func(sp *egv1a1.SecurityPolicy) {
@@ -1009,13 +1250,13 @@ func TestSecurityPolicyTarget(t *testing.T) {
 						Rules: []egv1a1.AuthorizationRule{
 							{
 								Action:    egv1a1.AuthorizationActionAllow,
-								Principal: egv1a1.Principal{},
+								Principal: &egv1a1.Principal{},
 							},
 						},
 					},
 				}
 			},
-			wantErrors: []string{"at least one of clientCIDRs or jwt must be specified"},
+			wantErrors: []string{"at least one of clientCIDRs, clientCIDRsFrom, jwt or clientCertificate must be specified"},
 		},
 		{
 			desc: "authorization-jwt-claims-without-jwt-authn",
@@ -1036,7 +1277,7 @@ func TestSecurityPolicyTarget(t *testing.T) {
 						Rules: []egv1a1.AuthorizationRule{
 							{
 								Action: egv1a1.AuthorizationActionAllow,
-								Principal: egv1a1.Principal{
+								Principal: &egv1a1.Principal{
 									JWT: &egv1a1.JWTPrincipal{
 										Claims: []egv1a1.JWTClaim{
 											{
@@ -1072,7 +1313,7 @@ func TestSecurityPolicyTarget(t *testing.T) {
 						Rules: []egv1a1.AuthorizationRule{
 							{
 								Action: egv1a1.AuthorizationActionAllow,
-								Principal: egv1a1.Principal{
+								Principal: &egv1a1.Principal{
 									JWT: &egv1a1.JWTPrincipal{},
 								},
 							},
@@ -1082,6 +1323,277 @@ func TestSecurityPolicyTarget(t *testing.T) {
 			},
 			wantErrors: []string{"at least one of claims or scopes must be specified"},
 		},
+		{
+			desc: "authorization-operation",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action: egv1a1.AuthorizationActionDeny,
+								Operation: &egv1a1.Operation{
+									Methods: []gwapiv1.HTTPMethod{gwapiv1.HTTPMethodDelete},
+									Paths: []egv1a1.StringMatch{
+										{
+											Type:  ptr.To(egv1a1.StringMatchPrefix),
+											Value: "/admin/",
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "authorization-client-certificate",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action: egv1a1.AuthorizationActionDeny,
+								Principal: &egv1a1.Principal{
+									ClientCertificate: &egv1a1.ClientCertificatePrincipal{
+										URISANs: []egv1a1.StringMatch{
+											{
+												Type:  ptr.To(egv1a1.StringMatchPrefix),
+												Value: "spiffe://cluster.local/ns/default/",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "authorization-empty-client-certificate",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action: egv1a1.AuthorizationActionDeny,
+								Principal: &egv1a1.Principal{
+									ClientCertificate: &egv1a1.ClientCertificatePrincipal{},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"at least one of uriSANs, dnsSANs or subjects must be specified"},
+		},
+		{
+			desc: "authorization-client-cidrs-from",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action: egv1a1.AuthorizationActionDeny,
+								Principal: &egv1a1.Principal{
+									ClientCIDRsFrom: []egv1a1.CIDRListSource{
+										{
+											Type: egv1a1.CIDRListSourceTypeConfigMap,
+											ConfigMap: &egv1a1.CIDRListConfigMapReference{
+												Name: "blocklist",
+												Key:  "cidrs",
+											},
+										},
+										{
+											Type: egv1a1.CIDRListSourceTypeFile,
+											File: &egv1a1.CIDRListFile{
+												Path: "/etc/envoy-gateway/blocklist.txt",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{},
+		},
+		{
+			desc: "authorization-client-cidrs-from-mismatched-type",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action: egv1a1.AuthorizationActionDeny,
+								Principal: &egv1a1.Principal{
+									ClientCIDRsFrom: []egv1a1.CIDRListSource{
+										{
+											Type: egv1a1.CIDRListSourceTypeConfigMap,
+											File: &egv1a1.CIDRListFile{
+												Path: "/etc/envoy-gateway/blocklist.txt",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{
+				"configMap must be set for type ConfigMap, and only for type ConfigMap",
+				"file must be set for type File, and only for type File",
+			},
+		},
+		{
+			desc: "authorization-client-cidrs-from-relative-file-path",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action: egv1a1.AuthorizationActionDeny,
+								Principal: &egv1a1.Principal{
+									ClientCIDRsFrom: []egv1a1.CIDRListSource{
+										{
+											Type: egv1a1.CIDRListSourceTypeFile,
+											File: &egv1a1.CIDRListFile{
+												Path: "blocklist.txt",
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"spec.authorization.rules[0].principal.clientCIDRsFrom[0].file.path in body should match '^/'"},
+		},
+		{
+			desc: "authorization-without-principal-and-operation",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action: egv1a1.AuthorizationActionDeny,
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"at least one of principal or operation must be specified"},
+		},
+		{
+			desc: "authorization-empty-operation",
+			mutate: func(sp *egv1a1.SecurityPolicy) {
+				sp.Spec = egv1a1.SecurityPolicySpec{
+					PolicyTargetReferences: egv1a1.PolicyTargetReferences{
+						TargetSelectors: []egv1a1.TargetSelector{
+							{
+								Group: ptr.To(gwapiv1a2.Group("gateway.networking.k8s.io")),
+								Kind:  "HTTPRoute",
+								MatchLabels: map[string]string{
+									"eg/namespace": "reference-apps",
+								},
+							},
+						},
+					},
+					Authorization: &egv1a1.Authorization{
+						Rules: []egv1a1.AuthorizationRule{
+							{
+								Action:    egv1a1.AuthorizationActionDeny,
+								Operation: &egv1a1.Operation{},
+							},
+						},
+					},
+				}
+			},
+			wantErrors: []string{"at least one of methods, paths, headers, hosts or serverNames must be specified"},
+		},
 		{
 			desc: "oidc-retry",
 			mutate: func(sp *egv1a1.SecurityPolicy) {
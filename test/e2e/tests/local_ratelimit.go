@@ -26,6 +26,8 @@ func init() {
 	ConformanceTests = append(ConformanceTests, LocalRateLimitAllTrafficTest)
 	ConformanceTests = append(ConformanceTests, LocalRateLimitNoLimitRouteTest)
 	ConformanceTests = append(ConformanceTests, LocalRateLimitHeaderInvertMatchTest)
+	ConformanceTests = append(ConformanceTests, LocalRateLimitSelectorsTest)
+	ConformanceTests = append(ConformanceTests, LocalRateLimitShadowModeTest)
 }
 
 var LocalRateLimitSpecificUserTest = suite.ConformanceTest{
@@ -296,3 +298,127 @@ var LocalRateLimitHeaderInvertMatchTest = suite.ConformanceTest{
 		})
 	},
 }
+
+var LocalRateLimitSelectorsTest = suite.ConformanceTest{
+	ShortName:   "LocalRateLimitSelectors",
+	Description: "Limit the requests selected by method, path and query parameters",
+	Manifests:   []string{"testdata/local-ratelimit-selectors.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		t.Run("limit the selected requests", func(t *testing.T) {
+			ns := "gateway-conformance-infra"
+			routeNN := types.NamespacedName{Name: "http-ratelimit-selectors", Namespace: ns}
+			gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+			gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+			ancestorRef := gwapiv1a2.ParentReference{
+				Group:     gatewayapi.GroupPtr(gwapiv1.GroupName),
+				Kind:      gatewayapi.KindPtr(resource.KindGateway),
+				Namespace: gatewayapi.NamespacePtr(gwNN.Namespace),
+				Name:      gwapiv1.ObjectName(gwNN.Name),
+			}
+			BackendTrafficPolicyMustBeAccepted(t, suite.Client, types.NamespacedName{Name: "ratelimit-selectors", Namespace: ns}, suite.ControllerName, ancestorRef)
+
+			// the requests should not be limited because the query parameter doesn't match
+			expectOkResp := http.ExpectedResponse{
+				Request: http.Request{
+					Path: "/ratelimit-selectors/limited?tier=paid",
+				},
+				Response: http.Response{
+					StatusCode: 200,
+				},
+				Namespace: ns,
+			}
+			expectOkReq := http.MakeRequest(t, &expectOkResp, gwAddr, "HTTP", "http")
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expectOkResp)
+			if err := GotExactExpectedResponse(t, 4, suite.RoundTripper, expectOkReq, expectOkResp); err != nil {
+				t.Errorf("fail to get expected response for the requests with another query parameter: %v", err)
+			}
+
+			// the requests should not be limited because the path doesn't match
+			expectOkResp = http.ExpectedResponse{
+				Request: http.Request{
+					Path: "/ratelimit-selectors/other?tier=free",
+				},
+				Response: http.Response{
+					StatusCode: 200,
+				},
+				Namespace: ns,
+			}
+			expectOkReq = http.MakeRequest(t, &expectOkResp, gwAddr, "HTTP", "http")
+			if err := GotExactExpectedResponse(t, 4, suite.RoundTripper, expectOkReq, expectOkResp); err != nil {
+				t.Errorf("fail to get expected response for the requests with another path: %v", err)
+			}
+
+			expectOkResp = http.ExpectedResponse{
+				Request: http.Request{
+					Path: "/ratelimit-selectors/limited?tier=free",
+				},
+				Response: http.Response{
+					StatusCode: 200,
+				},
+				Namespace: ns,
+			}
+			expectOkReq = http.MakeRequest(t, &expectOkResp, gwAddr, "HTTP", "http")
+
+			expectLimitResp := http.ExpectedResponse{
+				Request: http.Request{
+					Path: "/ratelimit-selectors/limited?tier=free",
+				},
+				Response: http.Response{
+					StatusCode: 429,
+				},
+				Namespace: ns,
+			}
+			expectLimitReq := http.MakeRequest(t, &expectLimitResp, gwAddr, "HTTP", "http")
+
+			// the first three selected requests should be allowed
+			if err := GotExactExpectedResponse(t, 3, suite.RoundTripper, expectOkReq, expectOkResp); err != nil {
+				t.Errorf("fail to get expected response at first three request: %v", err)
+			}
+
+			// this request should be limited because the limit is 3
+			if err := GotExactExpectedResponse(t, 1, suite.RoundTripper, expectLimitReq, expectLimitResp); err != nil {
+				t.Errorf("fail to get expected response at last fourth request: %v", err)
+			}
+		})
+	},
+}
+
+var LocalRateLimitShadowModeTest = suite.ConformanceTest{
+	ShortName:   "LocalRateLimitShadowMode",
+	Description: "Count the requests against the limits without rejecting them",
+	Manifests:   []string{"testdata/local-ratelimit-shadow-mode.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		t.Run("requests over the limit are not rejected", func(t *testing.T) {
+			ns := "gateway-conformance-infra"
+			routeNN := types.NamespacedName{Name: "http-ratelimit-shadow-mode", Namespace: ns}
+			gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+			gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+			ancestorRef := gwapiv1a2.ParentReference{
+				Group:     gatewayapi.GroupPtr(gwapiv1.GroupName),
+				Kind:      gatewayapi.KindPtr(resource.KindGateway),
+				Namespace: gatewayapi.NamespacePtr(gwNN.Namespace),
+				Name:      gwapiv1.ObjectName(gwNN.Name),
+			}
+			BackendTrafficPolicyMustBeAccepted(t, suite.Client, types.NamespacedName{Name: "ratelimit-shadow-mode", Namespace: ns}, suite.ControllerName, ancestorRef)
+
+			expectOkResp := http.ExpectedResponse{
+				Request: http.Request{
+					Path: "/ratelimit-shadow-mode",
+				},
+				Response: http.Response{
+					StatusCode: 200,
+				},
+				Namespace: ns,
+			}
+			expectOkReq := http.MakeRequest(t, &expectOkResp, gwAddr, "HTTP", "http")
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expectOkResp)
+
+			// the requests over the limit of 3 should not be rejected in shadow mode
+			if err := GotExactExpectedResponse(t, 5, suite.RoundTripper, expectOkReq, expectOkResp); err != nil {
+				t.Errorf("fail to get expected response in shadow mode: %v", err)
+			}
+		})
+	},
+}
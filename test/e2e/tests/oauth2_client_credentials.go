@@ -0,0 +1,91 @@
+// Copyright Envoy Gateway Authors
+// SPDX-License-Identifier: Apache-2.0
+// The full text of the Apache license is available in the LICENSE file at
+// the root of the repo.
+
+//go:build e2e
+
+package tests
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	gwapiv1 "sigs.k8s.io/gateway-api/apis/v1"
+	gwapiv1a2 "sigs.k8s.io/gateway-api/apis/v1alpha2"
+	"sigs.k8s.io/gateway-api/conformance/utils/http"
+	"sigs.k8s.io/gateway-api/conformance/utils/kubernetes"
+	"sigs.k8s.io/gateway-api/conformance/utils/suite"
+	"sigs.k8s.io/gateway-api/conformance/utils/tlog"
+
+	"github.com/envoyproxy/gateway/internal/gatewayapi"
+	"github.com/envoyproxy/gateway/internal/gatewayapi/resource"
+)
+
+func init() {
+	ConformanceTests = append(ConformanceTests, OAuth2ClientCredentialsTest)
+}
+
+var OAuth2ClientCredentialsTest = suite.ConformanceTest{
+	ShortName:   "OAuth2ClientCredentials",
+	Description: "Obtain an access token with the OAuth2 client credentials grant and inject it into the requests forwarded to the backend",
+	Manifests:   []string{"testdata/oidc-keycloak.yaml", "testdata/oauth2-client-credentials.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "http-with-oauth2-client-credentials", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		ancestorRef := gwapiv1a2.ParentReference{
+			Group:     gatewayapi.GroupPtr(gwapiv1.GroupName),
+			Kind:      gatewayapi.KindPtr(resource.KindGateway),
+			Namespace: gatewayapi.NamespacePtr(gwNN.Namespace),
+			Name:      gwapiv1.ObjectName(gwNN.Name),
+		}
+		BackendTrafficPolicyMustBeAccepted(t, suite.Client, types.NamespacedName{Name: "oauth2-client-credentials", Namespace: ns}, suite.ControllerName, ancestorRef)
+
+		// Wait for the keycloak pod to be configured with the test client
+		podInitialized := corev1.PodCondition{Type: corev1.PodInitialized, Status: corev1.ConditionTrue}
+		WaitForPods(t, suite.Client, ns, map[string]string{"job-name": "setup-keycloak"}, corev1.PodSucceeded, podInitialized)
+
+		t.Run("inject the access token into the Authorization header", func(t *testing.T) {
+			expectedResponse := http.ExpectedResponse{
+				Request: http.Request{
+					Path: "/oauth2-client-credentials",
+					Headers: map[string]string{
+						"Authorization": "Bearer client-token",
+					},
+				},
+				Response: http.Response{
+					StatusCode: 200,
+				},
+				Namespace: ns,
+			}
+
+			req := http.MakeRequest(t, &expectedResponse, gwAddr, "HTTP", "http")
+			http.AwaitConvergence(t, suite.TimeoutConfig.RequiredConsecutiveSuccesses, suite.TimeoutConfig.MaxTimeToConsistency,
+				func(_ time.Duration) bool {
+					cReq, cResp, err := suite.RoundTripper.CaptureRoundTrip(req)
+					if err != nil {
+						tlog.Logf(t, "request failed: %v", err)
+						return false
+					}
+					if cResp.StatusCode != expectedResponse.Response.StatusCode {
+						tlog.Logf(t, "expected status code %d, got %d", expectedResponse.Response.StatusCode, cResp.StatusCode)
+						return false
+					}
+
+					// The access token issued by the token endpoint must overwrite the client's Authorization header.
+					authorization := strings.Join(cReq.Headers["Authorization"], ",")
+					if !strings.HasPrefix(authorization, "Bearer ") || authorization == "Bearer client-token" {
+						tlog.Logf(t, "unexpected Authorization header received by the backend: %q", authorization)
+						return false
+					}
+					return true
+				})
+		})
+	},
+}
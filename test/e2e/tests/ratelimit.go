@@ -30,6 +30,7 @@ func init() {
 	ConformanceTests = append(ConformanceTests, RateLimitBasedJwtClaimsTest)
 	ConformanceTests = append(ConformanceTests, RateLimitMultipleListenersTest)
 	ConformanceTests = append(ConformanceTests, RateLimitHeadersAndCIDRMatchTest)
+	ConformanceTests = append(ConformanceTests, RateLimitCostTest)
 }
 
 var RateLimitCIDRMatchTest = suite.ConformanceTest{
@@ -675,3 +676,59 @@ func GotExactExpectedResponse(t *testing.T, n int, r roundtripper.RoundTripper,
 	}
 	return nil
 }
+
+var RateLimitCostTest = suite.ConformanceTest{
+	ShortName:   "RateLimitCost",
+	Description: "Limit requests based on the cost taken from a request header",
+	Manifests:   []string{"testdata/ratelimit-cost.yaml"},
+	Test: func(t *testing.T, suite *suite.ConformanceTestSuite) {
+		ns := "gateway-conformance-infra"
+		routeNN := types.NamespacedName{Name: "cost-ratelimit", Namespace: ns}
+		gwNN := types.NamespacedName{Name: "same-namespace", Namespace: ns}
+		gwAddr := kubernetes.GatewayAndHTTPRoutesMustBeAccepted(t, suite.Client, suite.TimeoutConfig, suite.ControllerName, kubernetes.NewGatewayRef(gwNN), routeNN)
+
+		t.Run("requests are limited by their cost", func(t *testing.T) {
+			expectOkResp := http.ExpectedResponse{
+				Request: http.Request{
+					Path: "/cost",
+					Headers: map[string]string{
+						"x-user-id":      "one",
+						"x-request-cost": "4",
+					},
+				},
+				Response: http.Response{
+					StatusCode: 200,
+				},
+				Namespace: ns,
+			}
+			expectOkReq := http.MakeRequest(t, &expectOkResp, gwAddr, "HTTP", "http")
+
+			expectLimitResp := http.ExpectedResponse{
+				Request: http.Request{
+					Path: "/cost",
+					Headers: map[string]string{
+						"x-user-id":      "one",
+						"x-request-cost": "4",
+					},
+				},
+				Response: http.Response{
+					StatusCode: 429,
+				},
+				Namespace: ns,
+			}
+			expectLimitReq := http.MakeRequest(t, &expectLimitResp, gwAddr, "HTTP", "http")
+
+			// the limit is 10, and each request costs 4, so the third request should be limited
+
+			// keep sending requests till get 200 first, that will cost one 200
+			http.MakeRequestAndExpectEventuallyConsistentResponse(t, suite.RoundTripper, suite.TimeoutConfig, gwAddr, expectOkResp)
+
+			if err := GotExactExpectedResponse(t, 1, suite.RoundTripper, expectOkReq, expectOkResp); err != nil {
+				t.Errorf("failed to get expected response for the second request: %v", err)
+			}
+			if err := GotExactExpectedResponse(t, 1, suite.RoundTripper, expectLimitReq, expectLimitResp); err != nil {
+				t.Errorf("failed to get expected response for the third request: %v", err)
+			}
+		})
+	},
+}